@@ -117,6 +117,20 @@ type Header struct {
 	// PAXレコードは、PAXRecordsよりも優先されます。
 	PAXRecords map[string]string
 
+	// SparseHolesは、スパースファイル内のホールの列を表します。
+	//
+	// len(SparseHoles) > 0の場合、ファイルはスパースです。
+	// スパースファイルは、ホール（このフィールドで記述されます）と交互に並んだ
+	// データの断片で構成されます。ホールは意味的にはNULバイトのブロックですが、
+	// 実際にはtarファイル内には存在しません。
+	// ホールは昇順にソートされ、互いに重ならず、Sizeを超えてはなりません。
+	//
+	// Reader.Nextは、GNUおよびPAXのスパースファイルについてこのフィールドを設定します。
+	// Writer.WriteHeaderは、このフィールドが空でない場合、
+	// PAXフォーマットでGNUスパースフォーマット1.0を使用してファイルを書き込みます。
+	// Sizeは常に、ホールを含むファイルの論理的なサイズです。
+	SparseHoles []SparseEntry
+
 	// Formatはtarヘッダーの形式を指定します。
 	//
 	// これは、Reader.Nextによって形式の最善の推測として設定されます。
@@ -128,6 +142,10 @@ type Header struct {
 	Format Format
 }
 
+// SparseEntryは、スパースファイル内の単一の領域を表します。
+// 領域は、オフセットOffsetから始まるLengthバイトです。
+type SparseEntry struct{ Offset, Length int64 }
+
 // fs.FileInfoのNameメソッドは、説明するファイルのベース名のみを返すため、
 // ファイルの完全なパス名を提供するためにHeader.Nameを変更する必要がある場合があります。
 //
@@ -36,7 +36,8 @@ package tar
 // 表の下部は、各フォーマットの特殊な機能を示しています。
 // たとえば、サポートされる文字列エンコーディング、サブセカンドタイムスタンプのサポート、スパースファイルのサポートなどがあります。
 //
-// Writerは現在、スパースファイルに対するサポートを提供していません。
+// Writerは、PAXフォーマットのスパースファイルのみを書き込みます
+// （GNUスパースフォーマット1.0を使用します。[Header.SparseHoles] を参照）。
 type Format int
 
 // Constants to identify various tar formats.
@@ -0,0 +1,27 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tar
+
+import (
+	"github.com/shogo82148/std/os"
+)
+
+// DetectSparseHolesは、fが指すファイルのホールを検出し、
+// その結果で [Header.SparseHoles] を設定します。
+// ファイルは、現在のオフセットにかかわらず先頭から調べられます。
+// fの読み書きのオフセットは、戻るときに先頭に戻されます。
+//
+// ホールの検出には、プラットフォームが対応している場合はSEEK_DATAとSEEK_HOLEが使用されます。
+// ファイルシステムがホールの検出をサポートしていない場合、DetectSparseHolesは
+// エラーを返さずにSparseHolesを空のままにします。
+func (h *Header) DetectSparseHoles(f *os.File) error
+
+// PunchSparseHolesは、[Header.SparseHoles] で記述されたホールをfに作成し、
+// fのサイズを [Header.Size] に設定します。
+// これは、[Reader] からスパースファイルを展開するときに、[io.Copy] の前に使用できます。
+//
+// プラットフォームがホールの作成をサポートしていない場合でも、PunchSparseHolesは
+// ファイルのサイズを設定するため、ホールの内容はNULバイトとして読み取られます。
+func (h *Header) PunchSparseHoles(f *os.File) error
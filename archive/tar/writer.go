@@ -49,10 +49,21 @@ func (tw *Writer) AddFS(fsys fs.FS) error
 // Writeは、tarアーカイブの現在のファイルに書き込みます。
 // [Writer.WriteHeader] の後にHeader.Sizeバイト以上が書き込まれた場合、Writeは [Writer.WriteHeader] エラーを返します。
 //
+// 現在のファイルがスパースの場合、ホールの領域に書き込まれるデータはすべてNULバイトである必要があり、
+// それらはアーカイブには書き込まれません。ホールにNUL以外のバイトを書き込むとエラーが返されます。
+//
 // [TypeLink] 、 [TypeSymlink] 、 [TypeChar] 、 [TypeBlock] 、 [TypeDir] 、 [TypeFifo] などの特殊なタイプでWriteを呼び出すと、
 // [Header.Size] が示す内容に関係なく、(0, [ErrWriteTooLong])が返されます。
 func (tw *Writer) Write(b []byte) (int, error)
 
+// ReadFromは、rの内容をtarアーカイブの現在のファイルに書き込みます。
+// rがio.EOFに達するまで、またはファイルの残りのバイト数を書き込むまで読み取ります。
+//
+// 現在のファイルがスパースの場合、rが [io.Seeker] を実装していれば、
+// ReadFromはホールの領域をシークで読み飛ばします。
+// そうでなければ、ホールの領域はすべてNULバイトとして読み取られる必要があります。
+func (tw *Writer) ReadFrom(r io.Reader) (int64, error)
+
 // Closeはパディングをフラッシュし、フッターを書き込むことでtarアーカイブを閉じます。
 // (以前の [Writer.WriteHeader] の呼び出しで)現在のファイルが完全に書き込まれていない場合、エラーが返されます。
 func (tw *Writer) Close() error
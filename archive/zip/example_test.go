@@ -90,3 +90,39 @@ func ExampleWriter_RegisterCompressor() {
 
 	// ファイルを w に追加します。
 }
+
+func ExampleUpdater() {
+	// 元のアーカイブを変更しないように、一時ファイルにコピーします。
+	src, err := os.Open("testdata/readme.zip")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer src.Close()
+	f, err := os.CreateTemp("", "readme-*.zip")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	size, err := io.Copy(f, src)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	u, err := zip.NewUpdater(f, f, size)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// マニフェストを追加します。既存のエントリは再圧縮されません。
+	w, err := u.Create("META-INF/MANIFEST.MF")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := io.WriteString(w, "Manifest-Version: 1.0\n"); err != nil {
+		log.Fatal(err)
+	}
+	if err := u.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zip
+
+import (
+	"github.com/shogo82148/std/io"
+)
+
+// Updaterは、既存のZIPアーカイブをその場で変更します。
+//
+// Updaterは、既存のエントリのローカルヘッダーと圧縮データには触れません。
+// 新しいエントリは元のアーカイブの末尾の後に追加され、
+// [Updater.Close] で残ったエントリと新しいエントリの中央ディレクトリがその後に書き込まれます。
+// そのため、変更されなかったファイルは解凍も再圧縮もされません。
+// 削除または置き換えられたエントリのデータ、および元の中央ディレクトリはアーカイブ内に残りますが、
+// 新しい中央ディレクトリからは参照されなくなります。
+//
+// 元の中央ディレクトリは新しい中央ディレクトリが書き込まれるまで上書きされないため、
+// Closeが完了する前に書き込みが中断された場合でも、
+// 元のサイズに切り詰めることで元のアーカイブを復元できます。
+type Updater struct {
+	r *Reader
+	w io.WriterAt
+
+	// files は、元のアーカイブのエントリのうち削除されていないものです。
+	files []*File
+
+	// cw は、元のアーカイブの末尾から w に書き込みます。
+	cw          *countWriter
+	dir         []*header
+	last        *fileWriter
+	closed      bool
+	compressors map[uint16]Compressor
+	comment     string
+}
+
+// NewUpdaterは、rから読み取られるサイズsizeのアーカイブを変更する新しい [Updater] を返します。
+// 変更はwに書き込まれます。rとwは通常、読み書き用に開かれた同じ [os.File] です。
+//
+// アーカイブの先頭にデータが付加されている場合（自己解凍形式の実行可能ファイルなど）、
+// そのオフセットは [NewReader] と同様に扱われます。
+func NewUpdater(r io.ReaderAt, w io.WriterAt, size int64) (*Updater, error)
+
+// Fileは、削除されていない元のアーカイブのエントリを、アーカイブ内の順序で返します。
+// 返された [File] は、[File.Open] で読み取ることができます。
+func (u *Updater) File() []*File
+
+// Deleteは、指定された名前を持つエントリを削除済みとしてマークします。
+// 同じ名前を持つエントリが複数ある場合は、すべて削除されます。
+// エントリが存在しない場合、Deleteは [fs.ErrNotExist] をラップしたエラーを返します。
+func (u *Updater) Delete(name string) error
+
+// Createは、[Writer.Create] と同様に、指定された名前を使用してファイルをアーカイブに追加します。
+// 同じ名前を持つ元のエントリがある場合、そのエントリは置き換えられます。
+// 次の [Updater.Create] 、 [Updater.CreateHeader] 、 [Updater.CreateRaw] 、
+// [Updater.Copy] 、または [Updater.Close] を呼び出す前に、ファイルの内容を [io.Writer] に書き込む必要があります。
+func (u *Updater) Create(name string) (io.Writer, error)
+
+// CreateHeaderは、[Writer.CreateHeader] と同様に、提供された [FileHeader] を使用して
+// ファイルをアーカイブに追加します。
+// 同じ名前を持つ元のエントリがある場合、そのエントリは置き換えられます。
+func (u *Updater) CreateHeader(fh *FileHeader) (io.Writer, error)
+
+// CreateRawは、[Writer.CreateRaw] と同様に、提供された [FileHeader] を使用して
+// ファイルをアーカイブに追加し、圧縮されずにそのまま書き込まれる [io.Writer] を返します。
+// 同じ名前を持つ元のエントリがある場合、そのエントリは置き換えられます。
+func (u *Updater) CreateRaw(fh *FileHeader) (io.Writer, error)
+
+// Copyは、別の [Reader] から取得されたファイルfを、[Writer.Copy] と同様に
+// 生の形式でアーカイブに追加します。
+// 同じ名前を持つ元のエントリがある場合、そのエントリは置き換えられます。
+func (u *Updater) Copy(f *File) error
+
+// RegisterCompressorは、特定のメソッドIDにカスタムの圧縮プログラムを登録または上書きします。
+// メソッドの圧縮プログラムが見つからない場合、[Updater] はパッケージレベルで圧縮プログラムを検索します。
+func (u *Updater) RegisterCompressor(method uint16, comp Compressor)
+
+// SetCommentは、中央ディレクトリのコメントフィールドを設定します。
+// 呼び出されない場合、元のアーカイブのコメントが保持されます。
+// [Updater.Close] を呼び出す前にのみ呼び出すことができます。
+func (u *Updater) SetComment(comment string) error
+
+// Closeは、残ったエントリと追加されたエントリの中央ディレクトリを書き込むことで
+// アーカイブの変更を終了します。基になるライターは閉じません。
+func (u *Updater) Close() error
+
+// Sizeは、[Updater.Close] の後のアーカイブのサイズをバイト単位で返します。
+// 新しいアーカイブは常に元のアーカイブ以上のサイズになります。
+func (u *Updater) Size() int64
@@ -1548,6 +1548,7 @@
 //
 //	-json
 //	    テスト出力を自動処理に適したJSONに変換します。
+//	    テストバイナリは-test.jsonで実行され、イベントを直接出力します。
 //	    エンコーディングの詳細については、'go doc test2json'を参照してください。
 //	    また、ビルド出力もJSONで出力します。'go help buildjson'を参照してください。
 //
@@ -3206,6 +3207,10 @@
 //	-json
 //	    JSON形式で詳細な出力とテスト結果をログに記録します。これは、
 //	    マシンが読み取れる形式で-vフラグと同じ情報を提供します。
+//	    イベントはテストバイナリ自身によって出力されるため、テストの出力が
+//	    フレーミング行と誤って解釈されることはありません。サブテスト、
+//	    t.Attrの値、ベンチマークのメトリクス、およびfuzzingの進捗も含まれます。
+//	    エンコーディングの詳細については、'go doc test2json'を参照してください。
 //
 //	-list regexp
 //	    正規表現に一致するテスト、ベンチマーク、fuzzテスト、または例をリストします。
@@ -120,6 +120,7 @@ In addition to the build flags, the flags handled by 'go test' itself are:
 
 	-json
 	    Convert test output to JSON suitable for automated processing.
+	    The test binary is run with -test.json and emits the events directly.
 	    See 'go doc test2json' for the encoding details.
 	    Also emits build output in JSON. See 'go help buildjson'.
 
@@ -245,6 +246,10 @@ control the execution of any test:
 	-json
 	    Log verbose output and test results in JSON. This presents the
 	    same information as the -v flag in a machine-readable format.
+	    The events are emitted by the test binary itself, so test output
+	    is never mistaken for framing lines. They include subtests,
+	    t.Attr values, benchmark metrics and fuzzing progress.
+	    See 'go doc test2json' for the encoding details.
 
 	-list regexp
 	    List tests, benchmarks, fuzz tests, or examples matching the regular
@@ -6,7 +6,7 @@
 //
 // 使用方法:
 //
-//	go tool test2json [-p pkg] [-t] [./pkg.test -test.v=test2json]
+//	go tool test2json [-p pkg] [-t] [./pkg.test -test.json]
 //
 // Test2jsonは、指定されたテストコマンドを実行し、その出力をJSONに変換します。
 // コマンドが指定されていない場合、test2jsonは標準入力からテストの出力を予期します。
@@ -21,6 +21,13 @@
 // テストは、-test.v=test2jsonで呼び出す必要があります。-test.vのみを使用することもできます
 // （または -test.v=true）、しかし、より低い信頼性の結果となります。
 //
+// テストバイナリが-test.jsonフラグで実行された場合、テストバイナリ自身が
+// 以下で説明するフォーマットのイベントを直接出力します。
+// この場合、test2jsonは出力を解析せず、各イベントに-pフラグのパッケージと
+// （-tフラグが指定されていれば）タイムスタンプを補って、そのまま書き出します。
+// -test.v=test2jsonの出力を変換するよりも信頼性が高いため、
+// テストバイナリが対応している場合はこちらを使用してください。
+//
 // "go test -json"コマンドはtest2jsonを正しく呼び出すことに対応しているため、
 // "go tool test2json"は、テストバイナリが"go test"とは別に実行される場合にのみ必要です。
 // 可能な限り"go test -json"を使用してください。
@@ -42,6 +49,20 @@
 //		Output      string
 //		OutputType  string
 //		FailedBuild string
+//		Key         string
+//		Value       string
+//		Iterations  int64
+//		Metrics     map[string]float64
+//		Fuzz        *FuzzProgress
+//	}
+//
+//	type FuzzProgress struct {
+//		Elapsed     float64 // 秒単位
+//		Execs       int64
+//		ExecsPerSec float64
+//		NewCorpus   int64
+//		Corpus      int64
+//		Workers     int
 //	}
 //
 // Timeフィールドはイベントが発生した時刻を保持しています。
@@ -58,6 +79,11 @@
 //	fail   - テストまたはベンチマークが失敗する
 //	output - テストが出力を行う
 //	skip   - テストがスキップされるか、パッケージにテストが含まれていない
+//	attr   - テストがT.Attrを呼び出す
+//	fuzz   - fuzzingの進捗が報告される
+//
+// "attr"と"fuzz"のイベントは、テストバイナリが-test.jsonで実行された場合にのみ
+// 出力されます。
 //
 // JSONストリームは常に "start" イベントで始まります。
 //
@@ -95,4 +121,19 @@
 // その追加の出力はベンチマーク名に設定されたTestを持つイベントのシーケンスとして報告され、
 // Action == "bench"または"fail"の最終イベントで終了します。
 // ベンチマークにはAction == "pause"のイベントはありません。
+//
+// KeyとValueフィールドはAction == "attr"の場合に設定され、
+// T.Attrに渡されたキーと値を保持します。
+//
+// テストバイナリが-test.jsonで実行された場合、各ベンチマークは
+// タイミング結果の出力行に加えて、Action == "bench"または"fail"の最終イベントで終了します。
+// このイベントのIterationsフィールドはベンチマークの反復回数（b.N）を、
+// Metricsフィールドは"ns/op"、"B/op"、"allocs/op"、およびb.ReportMetricで
+// 報告されたものを含む、単位からその値へのマップを保持します。
+//
+// FuzzフィールドはAction == "fuzz"の場合に設定され、fuzzingの進捗を示します。
+// Elapsedはfuzzingの開始からの経過時間、Execsはfuzzターゲットの実行回数、
+// ExecsPerSecは直近の実行速度、NewCorpusはこの実行で新たに見つかった
+// 興味深い入力の数、Corpusはコーパス全体の入力の数、Workersは
+// 並行して動作しているワーカーの数です。
 package main
@@ -0,0 +1,31 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"github.com/shogo82148/std/internal/zstd"
+)
+
+// Dictは、圧縮と解凍で共有されるZstandard辞書です。
+//
+// 辞書は、RFC 8878の5節で定義された形式（マジックナンバー、辞書ID、
+// エントロピーテーブル、およびコンテンツ）を持つか、
+// 任意のバイト列からなる生のコンテンツ辞書のいずれかです。
+// Dictは不変であり、複数のゴルーチンから同時に使用しても安全です。
+type Dict struct {
+	d *zstd.Dict
+}
+
+// ParseDictは、bをZstandard辞書として解析します。
+// bがZstandard辞書のマジックナンバーで始まる場合、bは
+// エントロピーテーブルを含む辞書として解析され、
+// 形式が不正な場合はエラーが返されます。
+// それ以外の場合、bは辞書IDが0の生のコンテンツ辞書として扱われます。
+//
+// ParseDictはbのコピーを保持するため、呼び出し元は戻った後にbを変更しても構いません。
+func ParseDict(b []byte) (*Dict, error)
+
+// IDは辞書IDを返します。生のコンテンツ辞書の場合は0を返します。
+func (d *Dict) ID() uint32
@@ -0,0 +1,73 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd_test
+
+import (
+	"github.com/shogo82148/std/bytes"
+	"github.com/shogo82148/std/compress/zstd"
+	"github.com/shogo82148/std/io"
+	"github.com/shogo82148/std/log"
+	"github.com/shogo82148/std/os"
+)
+
+func Example_writerReader() {
+	var buf bytes.Buffer
+	zw := zstd.NewWriter(&buf)
+
+	_, err := zw.Write([]byte("A long time ago in a galaxy far, far away..."))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	zr := zstd.NewReader(&buf)
+	if _, err := io.Copy(os.Stdout, zr); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := zr.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Output:
+	// A long time ago in a galaxy far, far away...
+}
+
+// 辞書を使用すると、小さなデータの圧縮率を改善することができます。
+// 圧縮器と展開器は、事前に同じ辞書を使用することに合意する必要があります。
+func Example_dictionary() {
+	// 生のコンテンツ辞書には、実際のデータに現れることが期待される部分文字列を含めます。
+	dict, err := zstd.ParseDict([]byte(`{"name":"","kind":"","tags":[]}`))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	zw, err := zstd.NewWriterDict(&buf, zstd.DefaultCompression, dict)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := io.WriteString(zw, `{"name":"gopher","kind":"mascot","tags":["go"]}`); err != nil {
+		log.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	// 同じ辞書を与えて解凍します。
+	zr := zstd.NewReaderDict(&buf, dict)
+	if _, err := io.Copy(os.Stdout, zr); err != nil {
+		log.Fatal(err)
+	}
+	if err := zr.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Output:
+	// {"name":"gopher","kind":"mascot","tags":["go"]}
+}
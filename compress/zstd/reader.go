@@ -0,0 +1,64 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"github.com/shogo82148/std/internal/zstd"
+	"github.com/shogo82148/std/io"
+)
+
+// Readerは、Zstandard形式の圧縮ストリームから非圧縮データを取得するために
+// 読み取り可能な [io.Reader] です。
+//
+// Zstandardストリームは連結された複数のフレームで構成されることがあります。
+// Readerから読み取ると、各フレームの非圧縮データの連結が返されます。
+// スキップ可能フレームは無視されます。
+//
+// フレームがコンテンツチェックサムを持つ場合、[Reader.Read] は
+// フレームの終端に到達した時点でチェックサムを検証し、
+// 一致しない場合は [ErrChecksum] を返します。
+type Reader struct {
+	dec zstd.Reader
+	err error
+}
+
+// NewReaderは、rを読み取る新しい [Reader] を作成します。
+// rが [io.ByteReader] も実装していない場合、
+// デコンプレッサーはrから必要以上のデータを読み取る可能性があります。
+//
+// 完了時に [Reader.Close] を呼び出すのは呼び出し元の責任です。
+func NewReader(r io.Reader) *Reader
+
+// NewReaderDictは [NewReader] と同様ですが、与えられた辞書を使用して
+// 辞書を参照するフレームを解凍します。
+// フレームヘッダーの辞書IDが一致する辞書が選ばれます。
+// 辞書IDを持たないフレームには、dictsの中にある生のコンテンツ辞書が使われます。
+// フレームがdictsに含まれない辞書を参照する場合、[Reader.Read] は [ErrDictionary] を返します。
+func NewReaderDict(r io.Reader, dicts ...*Dict) *Reader
+
+// Resetは [Reader] zの状態を破棄し、[NewReader] または [NewReaderDict] からの
+// 元の状態と同等にしますが、代わりにrから読み込みます。
+// 辞書とウィンドウサイズの上限は保持されます。
+// これにより、新しい [Reader] を割り当てる代わりに、[Reader] を再利用することができます。
+func (z *Reader) Reset(r io.Reader)
+
+// SetMaxWindowSizeは、zが受け入れるウィンドウサイズの上限をnバイトに設定します。
+// 上限を超えるウィンドウを要求するフレームを読み取ると、[ErrWindowSize] が返されます。
+// デフォルトの上限は128 MiBです。
+// SetMaxWindowSizeは、最初の [Reader.Read] の前に呼び出す必要があります。
+func (z *Reader) SetMaxWindowSize(n uint64)
+
+// Readは [io.Reader] を実装し、基になる [io.Reader] から非圧縮バイトを読み取ります。
+func (z *Reader) Read(p []byte) (n int, err error)
+
+// ReadByteは [io.ByteReader] を実装します。
+func (z *Reader) ReadByte() (byte, error)
+
+// WriteToは [io.WriterTo] を実装し、非圧縮データをwに直接書き込みます。
+func (z *Reader) WriteTo(w io.Writer) (int64, error)
+
+// Closeは [Reader] を閉じます。基になる [io.Reader] は閉じません。
+// チェックサムを検証するためには、[io.EOF] まで読み取る必要があります。
+func (z *Reader) Close() error
@@ -0,0 +1,83 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"github.com/shogo82148/std/internal/zstd"
+	"github.com/shogo82148/std/io"
+)
+
+// Writerは [io.WriteCloser] です。
+// Writerへの書き込みは圧縮されてwに書き込まれます。
+//
+// Writerは、Closeされるごとに1つのZstandardフレームを書き込みます。
+// フレームは常にxxhash64のコンテンツチェックサムを含みます。
+type Writer struct {
+	w     io.Writer
+	level int
+	dict  *Dict
+	enc   encoder
+
+	// 圧縮前のデータを保持するブロックバッファ。
+	buf []byte
+	// 出力ブロックのスクラッチ領域。
+	out []byte
+
+	wroteHeader bool
+	closed      bool
+	checksum    zstd.XXHash64
+	err         error
+}
+
+// NewWriterは新しい [Writer] を返します。
+// 返されたWriterに書き込まれたデータは圧縮され、wに書き込まれます。
+//
+// [Writer] が終了したら、呼び出し元はCloseを呼ぶ責任があります。
+// 書き込みはバッファリングされ、Closeが呼ばれるまでフラッシュされない場合があります。
+//
+// w に書き込まれた正確なバイト数は Go 1 の互換性保証の対象外です。
+// テストを含む呼び出し元は、正確に書き込まれたバイト数に依存してはいけません。
+func NewWriter(w io.Writer) *Writer
+
+// NewWriterLevelは [NewWriter] と同様ですが、[DefaultCompression] を仮定する代わりに
+// 圧縮レベルを指定します。
+//
+// 圧縮レベルは [DefaultCompression]、または [BestSpeed] から [BestCompression] までの
+// 整数値（両端含む）にすることができます。
+// より高いレベルでは一般的に圧縮がより効率的ですが、速度は遅くなります。
+// レベルが有効な場合、返されるエラーは nil になります。
+func NewWriterLevel(w io.Writer, level int) (*Writer, error)
+
+// NewWriterDictは [NewWriterLevel] と同様ですが、辞書を使用して圧縮します。
+// dictが辞書IDを持つ場合、そのIDはフレームヘッダーに書き込まれます。
+// wに書き込まれた圧縮データは、同じ辞書を与えられたリーダーによってのみ
+// 解凍できます（[NewReaderDict] を参照）。
+func NewWriterDict(w io.Writer, level int, dict *Dict) (*Writer, error)
+
+// Resetは [Writer] zの状態を破棄し、[NewWriter]、[NewWriterLevel] または
+// [NewWriterDict] の元の状態と同等にしますが、wに書き込みます。
+// 圧縮レベルと辞書は保持されます。
+// これにより、新しい [Writer] を割り当てる代わりに [Writer] を再利用することができます。
+func (z *Writer) Reset(w io.Writer)
+
+// Writeはpを圧縮された形式で基になる [io.Writer] に書き込みます。
+// 圧縮されたバイトは、[Writer] が閉じられるまで必ずフラッシュされるわけではありません。
+func (z *Writer) Write(p []byte) (int, error)
+
+// ReadFromは [io.ReaderFrom] を実装し、rからEOFまで読み取ったデータを圧縮します。
+func (z *Writer) ReadFrom(r io.Reader) (int64, error)
+
+// Flushは、保留中のデータをブロックとして圧縮し、基になるライターに書き込みます。
+//
+// これは、主に圧縮されたネットワークプロトコルで有用であり、リモートのリーダーが
+// それまでに書き込まれたすべてのデータを解凍できることを保証します。
+// データが書き込まれるまで、Flushは戻りません。
+// 基になるライターがエラーを返した場合、Flushはそのエラーを返します。
+func (z *Writer) Flush() error
+
+// Closeは、書き込まれていないデータを基になる [io.Writer] にフラッシュし、
+// 最終ブロックとチェックサムを書き込んで [Writer] を閉じます。
+// これは、基になる [io.Writer] を閉じません。
+func (z *Writer) Close() error
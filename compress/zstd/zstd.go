@@ -0,0 +1,52 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+zstdパッケージは、RFC 8878で指定されているZstandard形式の圧縮データの読み書きを実装します。
+
+この実装は、読み取り中に解凍するフィルターと、書き込み中に圧縮するフィルターを提供します。
+たとえば、圧縮されたデータをバッファに書き込むには：
+
+	var b bytes.Buffer
+	w := zstd.NewWriter(&b)
+	w.Write([]byte("hello, world\n"))
+	w.Close()
+
+そして、そのデータを読み戻すには：
+
+	r := zstd.NewReader(&b)
+	io.Copy(os.Stdout, r)
+	r.Close()
+*/
+package zstd
+
+import (
+	"github.com/shogo82148/std/errors"
+)
+
+// 圧縮レベルは、zstdコマンドラインツールのレベルと同じ尺度を使用します。
+// [DefaultCompression] はレベル3を選択します。
+const (
+	BestSpeed          = 1
+	BetterCompression  = 7
+	BestCompression    = 19
+	DefaultCompression = 0
+)
+
+var (
+	// ErrChecksumは、無効なチェックサムを持つZstandardデータを読み取る場合に返されます。
+	ErrChecksum = errors.New("zstd: invalid checksum")
+	// ErrDictionaryは、フレームが参照する辞書が [Reader] に与えられていない場合に返されます。
+	ErrDictionary = errors.New("zstd: unknown dictionary")
+	// ErrHeaderは、無効なフレームヘッダーを持つZstandardデータを読み取る場合に返されます。
+	ErrHeader = errors.New("zstd: invalid header")
+	// ErrWindowSizeは、フレームが要求するウィンドウサイズが
+	// [Reader] に設定された上限を超えている場合に返されます。
+	ErrWindowSize = errors.New("zstd: window size exceeds limit")
+)
+
+// CorruptInputErrorは、指定されたオフセットで破損した入力の存在を報告します。
+type CorruptInputError int64
+
+func (e CorruptInputError) Error() string
@@ -0,0 +1,102 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// argon2パッケージは、[RFC 9106] で定義されたパスワードハッシュ関数Argon2idを実装します。
+//
+// Argon2id はパスワードのハッシュ化に推奨される方式です。多くのメモリを
+// 必要とするため、GPU や専用ハードウェアを使った総当たり攻撃に対して
+// [crypto/pbkdf2] よりも強い耐性を持ちます。
+//
+// パスワードを保存するには [GenerateFromPassword] を使用し、
+// 保存されたハッシュと照合するには [CompareHashAndPassword] を使用します。
+// ハッシュは次のような PHC 文字列形式でエンコードされ、
+// 検証に必要なすべてのパラメータを含みます。
+//
+//	$argon2id$v=19$m=65536,t=3,p=4$<salt>$<hash>
+//
+// salt と hash はパディングなしの標準 base64 でエンコードされます。
+//
+// [RFC 9106]: https://www.rfc-editor.org/rfc/rfc9106.html
+package argon2
+
+import (
+	"github.com/shogo82148/std/errors"
+)
+
+// Version は、このパッケージが実装する Argon2 のバージョン (0x13) です。
+const Version = 0x13
+
+// ErrMismatchedHashAndPassword は、パスワードとハッシュが一致しない場合に
+// [CompareHashAndPassword] から返されます。
+var ErrMismatchedHashAndPassword = errors.New("crypto/argon2: hashedPassword is not the hash of the given password")
+
+// ErrInvalidHash は、ハッシュが有効な Argon2id の PHC 文字列でない場合に返されます。
+var ErrInvalidHash = errors.New("crypto/argon2: invalid hash format")
+
+// Params は Argon2id のコストパラメータです。
+type Params struct {
+	// Time は、メモリ上を通過する回数 (t) です。
+	Time uint32
+
+	// Memory は、KiB 単位のメモリ使用量 (m) です。
+	// 8*Threads 以上でなければなりません。
+	Memory uint32
+
+	// Threads は、並列度 (p) です。
+	Threads uint8
+
+	// KeyLen は、導出される鍵のバイト長です。4 以上でなければなりません。
+	KeyLen uint32
+
+	// SaltLen は、GenerateFromPassword が生成するソルトのバイト長です。
+	// 8 以上でなければなりません。
+	SaltLen uint32
+}
+
+// DefaultParams は、RFC 9106 セクション 4 で推奨される 2 番目のパラメータセットで、
+// メモリが制約された環境向けです (t=3、64 MiB、p=4、32 バイトの鍵、16 バイトのソルト)。
+// ほとんどのアプリケーションはこれを使用すべきです。
+// 異なるパラメータが必要な場合は、コピーを変更して
+// そのアドレスを [GenerateFromPassword] に渡してください。
+var DefaultParams = Params{
+	Time:    3,
+	Memory:  64 * 1024,
+	Threads: 4,
+	KeyLen:  32,
+	SaltLen: 16,
+}
+
+// HighMemoryParams は、RFC 9106 セクション 4 で最初に推奨されるパラメータセットです
+// (t=1、2 GiB、p=4、32 バイトの鍵、16 バイトのソルト)。
+var HighMemoryParams = Params{
+	Time:    1,
+	Memory:  2 * 1024 * 1024,
+	Threads: 4,
+	KeyLen:  32,
+	SaltLen: 16,
+}
+
+// Key は、password と salt から Argon2id を使って params.KeyLen バイトの鍵を導出します。
+// params.SaltLen は無視されます。
+//
+// RFC 9106 は、少なくとも 16 バイトのランダムなソルトを推奨しています。
+//
+// パラメータが範囲外の場合、Key はエラーを返します。
+func Key(password string, salt []byte, params *Params) ([]byte, error)
+
+// GenerateFromPassword は、ランダムなソルトを生成して password の Argon2id ハッシュを計算し、
+// それを PHC 文字列形式でエンコードして返します。
+// params が nil の場合、[DefaultParams] が使用されます。
+func GenerateFromPassword(password string, params *Params) (string, error)
+
+// CompareHashAndPassword は、PHC 文字列形式でエンコードされた Argon2id のハッシュ hash と
+// 平文の password を比較します。一致する場合は nil を、一致しない場合は
+// [ErrMismatchedHashAndPassword] を返します。
+// 比較は [crypto/subtle.ConstantTimeCompare] を使用して定数時間で行われます。
+func CompareHashAndPassword(hash, password string) error
+
+// ParseHash は、PHC 文字列形式でエンコードされた Argon2id のハッシュから
+// そのパラメータを取り出します。
+// 保存されたハッシュを現在のパラメータで再計算すべきかどうかを判断するために使用できます。
+func ParseHash(hash string) (*Params, error)
@@ -0,0 +1,32 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package argon2_test
+
+import (
+	"github.com/shogo82148/std/crypto/argon2"
+	"github.com/shogo82148/std/fmt"
+	"github.com/shogo82148/std/log"
+)
+
+func Example() {
+	// ユーザーの登録時に、パスワードのハッシュを計算して保存します。
+	hash, err := argon2.GenerateFromPassword("correct horse battery staple", nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// ログイン時に、入力されたパスワードを保存されたハッシュと照合します。
+	if err := argon2.CompareHashAndPassword(hash, "correct horse battery staple"); err != nil {
+		log.Fatal(err)
+	}
+
+	// 保存されたハッシュのパラメータが古ければ、再計算します。
+	params, err := argon2.ParseHash(hash)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(params.Memory < argon2.DefaultParams.Memory)
+	// Output: false
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// bcryptパッケージは、ProvosとMazièresが1999年に発表した
+// 適応型パスワードハッシュアルゴリズムbcryptを実装しています。
+//
+// 新しいアプリケーションでは [crypto/argon2] を使用すべきです。
+// このパッケージは主に、既存の bcrypt ハッシュを検証するために提供されています。
+//
+// ハッシュは、PHC 文字列形式の前身であるモジュラー暗号形式でエンコードされます。
+//
+//	$2b$10$<22 文字の salt><31 文字の hash>
+//
+// 2a、2b、2y の各バージョンのハッシュを検証できます。
+// [GenerateFromPassword] は常にバージョン 2b のハッシュを生成します。
+package bcrypt
+
+import (
+	"github.com/shogo82148/std/errors"
+)
+
+const (
+	MinCost     = 4
+	MaxCost     = 31
+	DefaultCost = 10
+)
+
+// ErrMismatchedHashAndPassword は、パスワードとハッシュが一致しない場合に
+// [CompareHashAndPassword] から返されます。
+var ErrMismatchedHashAndPassword = errors.New("crypto/bcrypt: hashedPassword is not the hash of the given password")
+
+// ErrInvalidHash は、ハッシュが有効な bcrypt のハッシュでない場合に返されます。
+var ErrInvalidHash = errors.New("crypto/bcrypt: invalid hash format")
+
+// ErrPasswordTooLong は、パスワードが 72 バイトより長い場合に
+// [GenerateFromPassword] から返されます。
+var ErrPasswordTooLong = errors.New("crypto/bcrypt: password length exceeds 72 bytes")
+
+// InvalidCostError は、コストが [MinCost] から [MaxCost] の範囲外であることを示します。
+type InvalidCostError int
+
+func (ic InvalidCostError) Error() string
+
+// GenerateFromPassword は、ランダムなソルトを生成して、指定されたコストで password の
+// bcrypt ハッシュを計算し、エンコードして返します。
+// cost が [MinCost] より小さい場合、[DefaultCost] が使用されます。
+// password が 72 バイトより長い場合、[ErrPasswordTooLong] を返します。
+func GenerateFromPassword(password string, cost int) (string, error)
+
+// CompareHashAndPassword は、bcrypt のハッシュ hash と平文の password を比較します。
+// 一致する場合は nil を、一致しない場合は [ErrMismatchedHashAndPassword] を返します。
+// 比較は [crypto/subtle.ConstantTimeCompare] を使用して定数時間で行われます。
+func CompareHashAndPassword(hash, password string) error
+
+// Cost は、ハッシュの作成に使用されたコストを返します。
+// 保存されたハッシュをより大きなコストで再計算すべきかどうかを判断するために使用できます。
+func Cost(hash string) (int, error)
@@ -0,0 +1,39 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cipher
+
+const (
+	// ChaCha20Poly1305KeySizeは、ChaCha20-Poly1305とXChaCha20-Poly1305の鍵のバイト長です。
+	ChaCha20Poly1305KeySize = 32
+
+	// ChaCha20Poly1305NonceSizeは、[NewChaCha20Poly1305] が返すAEADのノンスのバイト長です。
+	ChaCha20Poly1305NonceSize = 12
+
+	// XChaCha20Poly1305NonceSizeは、[NewXChaCha20Poly1305] が返すAEADのノンスのバイト長です。
+	XChaCha20Poly1305NonceSize = 24
+
+	// ChaCha20Poly1305Overheadは、平文と暗号文の長さの差であるタグのバイト長です。
+	ChaCha20Poly1305Overhead = 16
+)
+
+// NewChaCha20Poly1305は、与えられた256ビットの鍵を使用して、
+// RFC 8439で定義されたChaCha20-Poly1305 AEADを返します。
+//
+// ChaCha20-Poly1305は、AESのハードウェア支援がないプラットフォームでも
+// 高速かつ定数時間で動作します。
+//
+// ノンスは12バイトで、与えられた鍵に対して一意でなければなりません。
+// ランダムなノンスを使用する場合は、衝突のリスクを避けるために
+// [NewXChaCha20Poly1305] を使用してください。
+func NewChaCha20Poly1305(key []byte) (AEAD, error)
+
+// NewXChaCha20Poly1305は、与えられた256ビットの鍵を使用して、
+// 24バイトのノンスを受け付けるXChaCha20-Poly1305 AEADを返します。
+// これはdraft-irtf-cfrg-xchachaで定義されたもので、
+// golang.org/x/crypto/chacha20poly1305.NewXと互換性があります。
+//
+// ノンスが十分に長いため、ランダムに生成したノンスを衝突のリスクを
+// 無視できるレベルで安全に使用できます。
+func NewXChaCha20Poly1305(key []byte) (AEAD, error)
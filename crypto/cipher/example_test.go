@@ -329,3 +329,32 @@ func ExampleStreamWriter() {
 	fmt.Printf("%x\n", out.Bytes())
 	// Output: cf0495cc6f75dafc23948538e79904a9
 }
+
+func ExampleNewXChaCha20Poly1305() {
+	// 安全な場所から秘密鍵を読み込み、複数のSeal/Open呼び出しで再利用します。
+	// （もちろん、実際の用途にはこの例の鍵を使用しないでください。）
+	key, _ := hex.DecodeString("6368616e676520746869732070617373776f726420746f206120736563726574")
+	plaintext := []byte("exampleplaintext")
+
+	aead, err := cipher.NewXChaCha20Poly1305(key)
+	if err != nil {
+		panic(err)
+	}
+
+	// ノンスが24バイトあるため、ランダムに生成しても安全です。
+	// ノンスは暗号文の先頭に付加して保存します。
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		panic(err)
+	}
+	ciphertext := aead.Seal(nonce, nonce, plaintext, nil)
+
+	// 復号時には、先頭のノンスを取り出します。
+	nonce, ciphertext = ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	decrypted, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%s\n", decrypted)
+	// Output: exampleplaintext
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// scryptパッケージは、Colin Percivalによって設計され、[RFC 7914] で標準化された
+// 鍵導出関数scryptを提供します。
+//
+// 新しいアプリケーションでは [crypto/argon2] を使用すべきです。
+// このパッケージは主に、既存の scrypt ハッシュを検証するために提供されています。
+//
+// [GenerateFromPassword] と [CompareHashAndPassword] は、次のような
+// PHC 文字列形式でエンコードされたハッシュを使用します。
+//
+//	$scrypt$ln=15,r=8,p=1$<salt>$<hash>
+//
+// ln は N の底 2 の対数で、salt と hash はパディングなしの標準 base64 でエンコードされます。
+//
+// [RFC 7914]: https://www.rfc-editor.org/rfc/rfc7914.html
+package scrypt
+
+import (
+	"github.com/shogo82148/std/errors"
+)
+
+// ErrMismatchedHashAndPassword は、パスワードとハッシュが一致しない場合に
+// [CompareHashAndPassword] から返されます。
+var ErrMismatchedHashAndPassword = errors.New("crypto/scrypt: hashedPassword is not the hash of the given password")
+
+// ErrInvalidHash は、ハッシュが有効な scrypt の PHC 文字列でない場合に返されます。
+var ErrInvalidHash = errors.New("crypto/scrypt: invalid hash format")
+
+// Params は scrypt のコストパラメータです。
+type Params struct {
+	// N は CPU/メモリのコストパラメータで、1 より大きい 2 のべき乗でなければなりません。
+	N int
+
+	// R はブロックサイズパラメータです。
+	R int
+
+	// P は並列化パラメータです。R*P は 2³⁰ 未満でなければなりません。
+	P int
+
+	// KeyLen は、導出される鍵のバイト長です。
+	KeyLen int
+
+	// SaltLen は、GenerateFromPassword が生成するソルトのバイト長です。
+	SaltLen int
+}
+
+// DefaultParams は、対話的なログインに推奨されるパラメータです
+// (N=32768、r=8、p=1、32 バイトの鍵、16 バイトのソルト)。
+// 異なるパラメータが必要な場合は、コピーを変更して
+// そのアドレスを [GenerateFromPassword] に渡してください。
+var DefaultParams = Params{
+	N:       1 << 15,
+	R:       8,
+	P:       1,
+	KeyLen:  32,
+	SaltLen: 16,
+}
+
+// Key は、password と salt から scrypt を使って keyLength バイトの鍵を導出します。
+//
+// N が 1 より大きい 2 のべき乗でない場合や、r*p >= 2³⁰ の場合など、
+// パラメータが範囲外の場合、Key はエラーを返します。
+func Key(password string, salt []byte, N, r, p, keyLength int) ([]byte, error)
+
+// GenerateFromPassword は、ランダムなソルトを生成して password の scrypt ハッシュを計算し、
+// それを PHC 文字列形式でエンコードして返します。
+// params が nil の場合、[DefaultParams] が使用されます。
+func GenerateFromPassword(password string, params *Params) (string, error)
+
+// CompareHashAndPassword は、PHC 文字列形式でエンコードされた scrypt のハッシュ hash と
+// 平文の password を比較します。一致する場合は nil を、一致しない場合は
+// [ErrMismatchedHashAndPassword] を返します。
+// 比較は [crypto/subtle.ConstantTimeCompare] を使用して定数時間で行われます。
+func CompareHashAndPassword(hash, password string) error
+
+// ParseHash は、PHC 文字列形式でエンコードされた scrypt のハッシュから
+// そのパラメータを取り出します。
+func ParseHash(hash string) (*Params, error)
@@ -0,0 +1,109 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssh
+
+import (
+	"github.com/shogo82148/std/context"
+	"github.com/shogo82148/std/net"
+	"github.com/shogo82148/std/sync"
+	"github.com/shogo82148/std/time"
+)
+
+// HostKeyCallback は、ハンドシェイク中にサーバーのホスト鍵を検証するために呼び出されます。
+// ホスト鍵を受け入れる場合は nil を返さなければなりません。
+// hostname は [Dial] に渡されたアドレスです。
+type HostKeyCallback func(hostname string, remote net.Addr, key PublicKey) error
+
+// InsecureIgnoreHostKey は、任意のホスト鍵を受け入れる [HostKeyCallback] を返します。
+// 本番環境のコードでは使用すべきではありません。
+func InsecureIgnoreHostKey() HostKeyCallback
+
+// FixedHostKey は、key と一致するホスト鍵のみを受け入れる [HostKeyCallback] を返します。
+func FixedHostKey(key PublicKey) HostKeyCallback
+
+// AuthMethod は、クライアントの認証方法です。
+type AuthMethod interface {
+	method() string
+}
+
+// Password は、固定のパスワードで認証する [AuthMethod] を返します。
+func Password(secret string) AuthMethod
+
+// PasswordCallback は、認証時に prompt を呼び出してパスワードを取得する [AuthMethod] を返します。
+func PasswordCallback(prompt func() (secret string, err error)) AuthMethod
+
+// PublicKeys は、指定された署名者のいずれかで公開鍵認証を行う [AuthMethod] を返します。
+func PublicKeys(signers ...Signer) AuthMethod
+
+// PublicKeysCallback は [PublicKeys] と同様ですが、認証時に getSigners を呼び出します。
+// SSH エージェントから署名者を取得する場合などに使用します。
+func PublicKeysCallback(getSigners func() ([]Signer, error)) AuthMethod
+
+// ClientConfig は、SSH クライアントの設定です。
+// 使用後に変更してはいけません。
+type ClientConfig struct {
+	Config
+
+	// User は、認証に使用するユーザー名です。
+	User string
+
+	// Auth は、順に試される認証方法のリストです。
+	Auth []AuthMethod
+
+	// HostKeyCallback は、サーバーのホスト鍵を検証するために呼び出されます。
+	// 必須です。[crypto/ssh/knownhosts.New] で known_hosts ファイルに基づく
+	// コールバックを作成できます。
+	HostKeyCallback HostKeyCallback
+
+	// HostKeyAlgorithms は、受け入れるホスト鍵のアルゴリズムを優先順に並べたものです。
+	// nil の場合、デフォルトのリストが使用されます。
+	HostKeyAlgorithms []string
+
+	// BannerCallback が nil でない場合、サーバーが送信したバナーメッセージで呼び出されます。
+	BannerCallback func(message string) error
+
+	// ClientVersion は、サーバーに送信するバージョン文字列です。"SSH-2.0-" で始まらなければなりません。
+	// 空の場合、適切なデフォルト値が使用されます。
+	ClientVersion string
+
+	// Timeout は、TCP 接続の確立にかかる時間の上限です。ゼロの場合、制限はありません。
+	Timeout time.Duration
+}
+
+// Client は、SSH クライアントの接続です。
+// Client は複数のゴルーチンから同時に使用しても安全です。
+type Client struct {
+	Conn
+
+	handleForwardsOnce sync.Once
+	forwards           forwardList
+
+	mu              sync.Mutex
+	channelHandlers map[string]chan NewChannel
+}
+
+// Dial は、指定されたネットワークアドレスの SSH サーバーに接続し、
+// ハンドシェイクと認証を行って [Client] を返します。
+func Dial(network, addr string, config *ClientConfig) (*Client, error)
+
+// DialContext は [Dial] と同様ですが、コンテキストを受け取ります。
+// コンテキストは接続、ハンドシェイク、および認証に適用されます。
+func DialContext(ctx context.Context, network, addr string, config *ClientConfig) (*Client, error)
+
+// NewClientConn は、既存の接続 c 上でクライアントのハンドシェイクと認証を行います。
+// addr はホスト鍵の検証のために HostKeyCallback に渡されます。
+// 返された値を [NewClient] に渡して [Client] を作成します。
+func NewClientConn(c net.Conn, addr string, config *ClientConfig) (Conn, <-chan NewChannel, <-chan *Request, error)
+
+// NewClient は、[NewClientConn] が返した値から [Client] を作成します。
+func NewClient(c Conn, chans <-chan NewChannel, reqs <-chan *Request) *Client
+
+// HandleChannelOpen は、サーバーから開かれる channelType のチャネルを受け取るための
+// チャネルを返します。同じ channelType に対してすでに呼び出されている場合は nil を返します。
+// 処理されないチャネルの種類は拒否されます。
+func (c *Client) HandleChannelOpen(channelType string) <-chan NewChannel
+
+// NewSession は、新しいセッションを開きます。
+func (c *Client) NewSession() (*Session, error)
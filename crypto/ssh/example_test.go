@@ -0,0 +1,170 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssh_test
+
+import (
+	"github.com/shogo82148/std/bytes"
+	"github.com/shogo82148/std/crypto/ed25519"
+	"github.com/shogo82148/std/crypto/ssh"
+	"github.com/shogo82148/std/crypto/ssh/knownhosts"
+	"github.com/shogo82148/std/fmt"
+	"github.com/shogo82148/std/log"
+	"github.com/shogo82148/std/net"
+	"github.com/shogo82148/std/os"
+	"github.com/shogo82148/std/path/filepath"
+)
+
+func ExampleDial() {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// known_hosts ファイルに記録されたホスト鍵でサーバーを検証します。
+	hostKeyCallback, err := knownhosts.New(filepath.Join(home, ".ssh", "known_hosts"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	key, err := os.ReadFile(filepath.Join(home, ".ssh", "id_ed25519"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	signer, err := ssh.ParsePrivateKey(key)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	config := &ssh.ClientConfig{
+		User:            "deploy",
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: hostKeyCallback,
+	}
+	client, err := ssh.Dial("tcp", "bastion.example.com:22", config)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer session.Close()
+
+	var stdout bytes.Buffer
+	session.Stdout = &stdout
+	if err := session.Run("uptime"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Print(stdout.String())
+}
+
+func ExampleClient_Dial() {
+	var hostKey ssh.PublicKey
+	config := &ssh.ClientConfig{
+		User: "username",
+		Auth: []ssh.AuthMethod{
+			ssh.Password("password"),
+		},
+		HostKeyCallback: ssh.FixedHostKey(hostKey),
+	}
+
+	// 踏み台サーバーに接続します。
+	client, err := ssh.Dial("tcp", "bastion.example.com:22", config)
+	if err != nil {
+		log.Fatal("Failed to dial: ", err)
+	}
+	defer client.Close()
+
+	// 踏み台サーバーを経由して内部のホストに接続します。
+	conn, err := client.Dial("tcp", "10.0.0.5:22")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer conn.Close()
+}
+
+func ExampleNewServerConn() {
+	_, hostPriv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	hostKey, err := ssh.NewSignerFromKey(hostPriv)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	serverConfig := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if conn.User() == "gopher" && string(password) == "secret" {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("password rejected for %q", conn.User())
+		},
+	}
+	serverConfig.AddHostKey(hostKey)
+
+	// net.Pipe で接続したクライアントとサーバーを使用します。
+	c1, c2 := net.Pipe()
+
+	go func() {
+		conn, chans, reqs, err := ssh.NewServerConn(c1, serverConfig)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+		go ssh.DiscardRequests(reqs)
+
+		for newChannel := range chans {
+			if newChannel.ChannelType() != "session" {
+				newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+				continue
+			}
+			channel, requests, err := newChannel.Accept()
+			if err != nil {
+				log.Fatal(err)
+			}
+			go func() {
+				for req := range requests {
+					if req.Type != "exec" {
+						req.Reply(false, nil)
+						continue
+					}
+					req.Reply(true, nil)
+					fmt.Fprintln(channel, "hello from server")
+					// 終了状態 0 を送信します。
+					channel.SendRequest("exit-status", false, []byte{0, 0, 0, 0})
+					channel.Close()
+				}
+			}()
+		}
+	}()
+
+	clientConfig := &ssh.ClientConfig{
+		User:            "gopher",
+		Auth:            []ssh.AuthMethod{ssh.Password("secret")},
+		HostKeyCallback: ssh.FixedHostKey(hostKey.PublicKey()),
+	}
+	conn, chans, reqs, err := ssh.NewClientConn(c2, "pipe", clientConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+	client := ssh.NewClient(conn, chans, reqs)
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer session.Close()
+
+	out, err := session.Output("greet")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Print(string(out))
+	// Output: hello from server
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssh
+
+import (
+	"github.com/shogo82148/std/crypto"
+	"github.com/shogo82148/std/io"
+)
+
+// 公開鍵アルゴリズムの名前です。
+const (
+	KeyAlgoED25519  = "ssh-ed25519"
+	KeyAlgoECDSA256 = "ecdsa-sha2-nistp256"
+	KeyAlgoECDSA384 = "ecdsa-sha2-nistp384"
+	KeyAlgoECDSA521 = "ecdsa-sha2-nistp521"
+	KeyAlgoRSA      = "ssh-rsa"
+
+	// KeyAlgoRSASHA256 と KeyAlgoRSASHA512 は、RFC 8332 で定義された
+	// ssh-rsa 鍵に対する署名アルゴリズムです。
+	KeyAlgoRSASHA256 = "rsa-sha2-256"
+	KeyAlgoRSASHA512 = "rsa-sha2-512"
+)
+
+// PublicKey は、SSH のワイヤーフォーマットで表される公開鍵です。
+type PublicKey interface {
+	// Type は、[KeyAlgoED25519] のような鍵の種類を返します。
+	Type() string
+
+	// Marshal は、RFC 4253 Section 6.6 のワイヤーフォーマットで鍵をエンコードします。
+	Marshal() []byte
+
+	// Verify は、data に対する署名 sig を検証します。
+	Verify(data []byte, sig *Signature) error
+}
+
+// CryptoPublicKey は、基礎となる [crypto.PublicKey] を公開する [PublicKey] です。
+// このパッケージが返すすべての PublicKey はこのインターフェースを実装します。
+type CryptoPublicKey interface {
+	CryptoPublicKey() crypto.PublicKey
+}
+
+// Signer は、秘密鍵を使ってデータに署名します。
+type Signer interface {
+	// PublicKey は、対応する公開鍵を返します。
+	PublicKey() PublicKey
+
+	// Sign は、data に署名します。rsa 鍵の場合、[KeyAlgoRSASHA512] が使用されます。
+	Sign(rand io.Reader, data []byte) (*Signature, error)
+}
+
+// AlgorithmSigner は、署名アルゴリズムを指定して署名できる [Signer] です。
+type AlgorithmSigner interface {
+	Signer
+
+	// SignWithAlgorithm は、algorithm を使用して data に署名します。
+	// algorithm が空の場合、鍵のデフォルトのアルゴリズムが使用されます。
+	SignWithAlgorithm(rand io.Reader, data []byte, algorithm string) (*Signature, error)
+}
+
+// Signature は、SSH のワイヤーフォーマットで表される署名です。
+type Signature struct {
+	Format string
+	Blob   []byte
+}
+
+// ParsePublicKey は、ワイヤーフォーマットでエンコードされた公開鍵を解析します。
+func ParsePublicKey(in []byte) (PublicKey, error)
+
+// NewPublicKey は、*ecdsa.PublicKey、*rsa.PublicKey、または ed25519.PublicKey を
+// [PublicKey] に変換します。
+func NewPublicKey(key crypto.PublicKey) (PublicKey, error)
+
+// ParseAuthorizedKey は、OpenSSH の authorized_keys ファイルの形式で書かれた
+// 最初の公開鍵を解析します。オプション、コメント、および残りの入力も返します。
+func ParseAuthorizedKey(in []byte) (out PublicKey, comment string, options []string, rest []byte, err error)
+
+// MarshalAuthorizedKey は、authorized_keys ファイルの形式で公開鍵をエンコードします。
+// 結果は改行で終わります。
+func MarshalAuthorizedKey(key PublicKey) []byte
+
+// FingerprintSHA256 は、OpenSSH と同じ "SHA256:" で始まる形式で
+// 公開鍵のフィンガープリントを返します。
+func FingerprintSHA256(key PublicKey) string
+
+// NewSignerFromKey は、*ecdsa.PrivateKey、*rsa.PrivateKey、ed25519.PrivateKey、
+// または [crypto.Signer] から [Signer] を作成します。
+func NewSignerFromKey(key any) (Signer, error)
+
+// ParsePrivateKey は、PEM でエンコードされた秘密鍵を解析して [Signer] を返します。
+// OpenSSH 形式、PKCS #1、PKCS #8、および SEC 1 の鍵がサポートされます。
+// 鍵がパスフレーズで保護されている場合、[*PassphraseMissingError] を返します。
+func ParsePrivateKey(pemBytes []byte) (Signer, error)
+
+// ParsePrivateKeyWithPassphrase は [ParsePrivateKey] と同様ですが、
+// パスフレーズで保護された OpenSSH 形式の鍵を復号します。
+func ParsePrivateKeyWithPassphrase(pemBytes, passphrase []byte) (Signer, error)
+
+// PassphraseMissingError は、秘密鍵がパスフレーズで保護されている場合に
+// [ParsePrivateKey] から返されます。
+type PassphraseMissingError struct {
+	// PublicKey は、鍵ファイルに平文で含まれている場合の公開鍵です。
+	PublicKey PublicKey
+}
+
+func (e *PassphraseMissingError) Error() string
@@ -0,0 +1,67 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// knownhostsパッケージは、OpenSSHのknown_hostsファイルの形式を扱います。
+// [New] は、known_hostsファイルに記録されたホスト鍵でサーバーを検証する
+// [ssh.HostKeyCallback] を返します。
+//
+// ハッシュ化されたホスト名（"|1|" で始まるもの）、ワイルドカードと否定のパターン、
+// 非標準ポートの "[host]:port" 形式、および @revoked と @cert-authority マーカーを
+// サポートします。@cert-authority の行は解析されますが、SSH 証明書は
+// サポートされないため、照合には使用されません。
+package knownhosts
+
+import (
+	"github.com/shogo82148/std/crypto/ssh"
+	"github.com/shogo82148/std/io"
+)
+
+// New は、指定された known_hosts ファイルを読み込み、それらに基づいてホスト鍵を
+// 検証する [ssh.HostKeyCallback] を返します。
+//
+// コールバックは、ホストの鍵がファイルに記録されていて一致する場合に nil を返します。
+// ホストが見つからないか鍵が一致しない場合は [*KeyError] を、
+// 鍵が @revoked として記録されている場合は [*RevokedError] を返します。
+func New(files ...string) (ssh.HostKeyCallback, error)
+
+// NewFromReader は [New] と同様ですが、r から known_hosts の内容を読み込みます。
+// name はエラーメッセージで使用されます。
+func NewFromReader(r io.Reader, name string) (ssh.HostKeyCallback, error)
+
+// KnownKey は、known_hosts ファイルに記録された鍵とその位置です。
+type KnownKey struct {
+	Key      ssh.PublicKey
+	Filename string
+	Line     int
+}
+
+func (k *KnownKey) String() string
+
+// KeyError は、ホスト鍵が known_hosts の記録と一致しない場合に返されます。
+// Want が空の場合、ホストは known_hosts に記録されていません。
+// 空でない場合、ホストは記録されていますが鍵が異なり、Want は記録された鍵です。
+// これは中間者攻撃の兆候である可能性があります。
+type KeyError struct {
+	Want []KnownKey
+}
+
+func (u *KeyError) Error() string
+
+// RevokedError は、ホスト鍵が @revoked として記録されている場合に返されます。
+type RevokedError struct {
+	Revoked KnownKey
+}
+
+func (r *RevokedError) Error() string
+
+// Line は、addresses と key から known_hosts ファイルの 1 行を作成します。
+// 結果は改行を含みません。
+func Line(addresses []string, key ssh.PublicKey) string
+
+// Normalize は、アドレスを known_hosts ファイルで使用される形式に正規化します。
+// ポート 22 は省略され、それ以外のポートは "[host]:port" の形式になります。
+func Normalize(address string) string
+
+// HashHostname は、OpenSSH の HashKnownHosts と同じ形式でホスト名をハッシュ化します。
+func HashHostname(hostname string) string
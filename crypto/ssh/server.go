@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssh
+
+import (
+	"github.com/shogo82148/std/net"
+)
+
+// Permissions は、認証コールバックが認証されたユーザーに関連付ける情報です。
+// [ServerConn.Permissions] から参照できます。
+type Permissions struct {
+	// CriticalOptions は、authorized_keys の command= のような、
+	// サーバーが理解して強制しなければならないオプションです。
+	CriticalOptions map[string]string
+
+	// Extensions は、サーバーが任意に解釈できる追加情報です。
+	Extensions map[string]string
+}
+
+// ServerConfig は、SSH サーバーの設定です。
+// 使用後に変更してはいけません。
+type ServerConfig struct {
+	Config
+
+	// NoClientAuth が true の場合、クライアントは認証なしで接続できます。
+	NoClientAuth bool
+
+	// MaxAuthTries は、接続ごとに許可される認証の試行回数です。
+	// ゼロの場合は 6 です。負の場合、制限はありません。
+	MaxAuthTries int
+
+	// PasswordCallback が nil でない場合、パスワード認証が有効になります。
+	// パスワードが正しい場合、nil でないエラーを返してはいけません。
+	PasswordCallback func(conn ConnMetadata, password []byte) (*Permissions, error)
+
+	// PublicKeyCallback が nil でない場合、公開鍵認証が有効になります。
+	// クライアントが鍵を所有していることは、コールバックの前後でサーバーが検証します。
+	// コールバックは、鍵がユーザーに対して許可されているかどうかのみを判断します。
+	PublicKeyCallback func(conn ConnMetadata, key PublicKey) (*Permissions, error)
+
+	// AuthLogCallback が nil でない場合、各認証の試行の後に呼び出されます。
+	AuthLogCallback func(conn ConnMetadata, method string, err error)
+
+	// BannerCallback が nil でない場合、認証の前にクライアントに送信するバナーを返します。
+	BannerCallback func(conn ConnMetadata) string
+
+	// ServerVersion は、クライアントに送信するバージョン文字列です。"SSH-2.0-" で始まらなければなりません。
+	// 空の場合、適切なデフォルト値が使用されます。
+	ServerVersion string
+
+	hostKeys []Signer
+}
+
+// AddHostKey は、サーバーのホスト鍵を追加します。
+// 同じ種類のホスト鍵がすでにある場合は置き換えられます。
+// 少なくとも 1 つのホスト鍵が必要です。
+func (s *ServerConfig) AddHostKey(key Signer)
+
+// ServerConn は、認証されたサーバー側の SSH 接続です。
+type ServerConn struct {
+	Conn
+
+	// Permissions は、認証に成功したコールバックが返した値です。
+	Permissions *Permissions
+}
+
+// NewServerConn は、c 上でサーバーのハンドシェイクとクライアントの認証を行います。
+// 成功した場合、接続と、クライアントから開かれるチャネルとグローバルリクエストを
+// 受け取るためのチャネルを返します。
+// 接続を維持するため、呼び出し元は両方のチャネルを処理し続けなければなりません。
+// グローバルリクエストを使用しない場合は、[DiscardRequests] に渡します。
+func NewServerConn(c net.Conn, config *ServerConfig) (*ServerConn, <-chan NewChannel, <-chan *Request, error)
@@ -0,0 +1,154 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssh
+
+import (
+	"github.com/shogo82148/std/errors"
+	"github.com/shogo82148/std/io"
+	"github.com/shogo82148/std/sync"
+)
+
+// Signal は、RFC 4254 Section 6.10 で定義されたシグナルの名前です。
+type Signal string
+
+const (
+	SIGABRT Signal = "ABRT"
+	SIGALRM Signal = "ALRM"
+	SIGFPE  Signal = "FPE"
+	SIGHUP  Signal = "HUP"
+	SIGILL  Signal = "ILL"
+	SIGINT  Signal = "INT"
+	SIGKILL Signal = "KILL"
+	SIGPIPE Signal = "PIPE"
+	SIGQUIT Signal = "QUIT"
+	SIGSEGV Signal = "SEGV"
+	SIGTERM Signal = "TERM"
+	SIGUSR1 Signal = "USR1"
+	SIGUSR2 Signal = "USR2"
+)
+
+// TerminalModes は、擬似端末のモードを RFC 4254 Section 8 のオペコードから値へ対応付けたものです。
+type TerminalModes map[uint8]uint32
+
+// 擬似端末のモードのオペコードの一部です。
+const (
+	ECHO          = 53
+	TTY_OP_ISPEED = 128
+	TTY_OP_OSPEED = 129
+)
+
+// Session は、リモートホスト上のプログラムの実行を表します。
+// Session は 1 回の Run、Start、Shell、または Output の呼び出しにのみ使用できます。
+type Session struct {
+	// Stdin は、リモートプロセスの標準入力です。
+	// nil の場合、空の入力が使用されます。
+	Stdin io.Reader
+
+	// Stdout と Stderr は、リモートプロセスの標準出力と標準エラーです。
+	// nil の場合、出力は破棄されます。
+	Stdout io.Writer
+	Stderr io.Writer
+
+	ch        Channel
+	started   bool
+	copyFuncs []func() error
+	errors    chan error
+
+	stdinpipe, stdoutpipe, stderrpipe bool
+
+	stdinPipeWriter io.WriteCloser
+
+	exitStatus chan error
+	closeOnce  sync.Once
+}
+
+// SendRequest は、セッションのチャネルにリクエストを送信します。
+func (s *Session) SendRequest(name string, wantReply bool, payload []byte) (bool, error)
+
+// Setenv は、コマンドの実行前に環境変数を設定します。
+// サーバーは、許可されていない変数を拒否することがあります。
+func (s *Session) Setenv(name, value string) error
+
+// RequestPty は、指定された端末の種類、サイズ、およびモードで擬似端末を要求します。
+func (s *Session) RequestPty(term string, h, w int, termmodes TerminalModes) error
+
+// WindowChange は、擬似端末のサイズの変更をリモートに通知します。
+func (s *Session) WindowChange(h, w int) error
+
+// Signal は、リモートプロセスにシグナルを送信します。
+func (s *Session) Signal(sig Signal) error
+
+// Start は、リモートホスト上で cmd を実行します。
+// 通常、cmd はリモートのシェルによって解釈されます。
+func (s *Session) Start(cmd string) error
+
+// Run は、リモートホスト上で cmd を実行し、その終了を待ちます。
+// コマンドが正常に終了し、入出力のコピーに問題がなかった場合、返されるエラーは nil です。
+// コマンドが 0 以外の状態で終了した場合やシグナルで終了した場合、エラーは [*ExitError] です。
+func (s *Session) Run(cmd string) error
+
+// Output は cmd を実行し、その標準出力を返します。
+func (s *Session) Output(cmd string) ([]byte, error)
+
+// CombinedOutput は cmd を実行し、その標準出力と標準エラーを結合したものを返します。
+func (s *Session) CombinedOutput(cmd string) ([]byte, error)
+
+// Shell は、リモートホスト上でログインシェルを開始します。
+func (s *Session) Shell() error
+
+// RequestSubsystem は、"sftp" のようなサブシステムを開始します。
+func (s *Session) RequestSubsystem(subsystem string) error
+
+// Wait は、リモートコマンドの終了と入出力のコピーの完了を待ちます。
+// 戻り値の意味は [Session.Run] と同じです。
+func (s *Session) Wait() error
+
+// StdinPipe は、コマンドの開始時にリモートの標準入力に接続されるパイプを返します。
+// パイプを閉じると、リモートに EOF が送信されます。
+func (s *Session) StdinPipe() (io.WriteCloser, error)
+
+// StdoutPipe は、コマンドの開始時にリモートの標準出力に接続されるパイプを返します。
+// すべての出力を読み取るまで Wait を呼び出してはいけません。
+func (s *Session) StdoutPipe() (io.Reader, error)
+
+// StderrPipe は、コマンドの開始時にリモートの標準エラーに接続されるパイプを返します。
+func (s *Session) StderrPipe() (io.Reader, error)
+
+// Close は、セッションを閉じます。
+func (s *Session) Close() error
+
+// ExitError は、リモートコマンドが正常に終了しなかったことを報告します。
+type ExitError struct {
+	Waitmsg
+}
+
+func (e *ExitError) Error() string
+
+// Waitmsg は、リモートコマンドの終了状態を保持します。
+type Waitmsg struct {
+	status int
+	signal string
+	msg    string
+	lang   string
+}
+
+// ExitStatus は、リモートコマンドの終了コードを返します。
+func (w Waitmsg) ExitStatus() int
+
+// Signal は、リモートコマンドを終了させたシグナルの名前を返します。
+// シグナルで終了しなかった場合は空です。
+func (w Waitmsg) Signal() string
+
+// Msg は、シグナルとともにサーバーが送信したエラーメッセージを返します。
+func (w Waitmsg) Msg() string
+
+// Lang は、Msg の言語タグを返します。
+func (w Waitmsg) Lang() string
+
+func (w Waitmsg) String() string
+
+// ErrExitMissing は、サーバーが終了状態を送信せずにセッションを閉じた場合に
+// [Session.Wait] から返されます。
+var ErrExitMissing = errors.New("ssh: remote command exited without exit status or exit signal")
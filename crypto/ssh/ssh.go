@@ -0,0 +1,214 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// sshパッケージは、SSHのクライアントとサーバーを実装しています。
+// プロトコルは [RFC 4251] から [RFC 4254] で定義されています。
+//
+// クライアントは [Dial] または [NewClientConn] で SSH サーバーに接続し、
+// [Client.NewSession] でコマンドを実行し、[Client.Dial] と [Client.Listen] で
+// ポートフォワーディングを行います。
+//
+// サーバーは [NewServerConn] で接続を受け入れ、返される [NewChannel] と
+// [Request] のチャネルを処理します。
+//
+// [NewClientConn] と [NewServerConn] は任意の [net.Conn] を受け取るため、テストでは
+// [net.Pipe] で接続したクライアントとサーバーを使用できます。
+//
+// サポートされるアルゴリズムは次のとおりです。
+//
+//   - 鍵交換: mlkem768x25519-sha256、curve25519-sha256、ecdh-sha2-nistp256/384/521
+//   - ホスト鍵と公開鍵認証: ssh-ed25519、ecdsa-sha2-nistp256/384/521、rsa-sha2-256/512
+//   - 暗号: aes128-gcm@openssh.com、aes256-gcm@openssh.com、chacha20-poly1305@openssh.com、aes128-ctr、aes256-ctr
+//   - MAC（CTR モードの場合）: hmac-sha2-256-etm@openssh.com、hmac-sha2-512-etm@openssh.com
+//
+// SHA-1 を使用するアルゴリズム（ssh-rsa 署名や diffie-hellman-group14-sha1 など）はサポートされません。
+//
+// [RFC 4251]: https://www.rfc-editor.org/rfc/rfc4251.html
+// [RFC 4254]: https://www.rfc-editor.org/rfc/rfc4254.html
+package ssh
+
+import (
+	"github.com/shogo82148/std/errors"
+	"github.com/shogo82148/std/io"
+	"github.com/shogo82148/std/net"
+)
+
+// 鍵交換アルゴリズムの名前です。
+const (
+	KeyExchangeMLKEM768X25519 = "mlkem768x25519-sha256"
+	KeyExchangeCurve25519     = "curve25519-sha256"
+	KeyExchangeECDHP256       = "ecdh-sha2-nistp256"
+	KeyExchangeECDHP384       = "ecdh-sha2-nistp384"
+	KeyExchangeECDHP521       = "ecdh-sha2-nistp521"
+)
+
+// 暗号アルゴリズムの名前です。
+const (
+	CipherAES128GCM        = "aes128-gcm@openssh.com"
+	CipherAES256GCM        = "aes256-gcm@openssh.com"
+	CipherChaCha20Poly1305 = "chacha20-poly1305@openssh.com"
+	CipherAES128CTR        = "aes128-ctr"
+	CipherAES256CTR        = "aes256-ctr"
+)
+
+// MAC アルゴリズムの名前です。
+const (
+	MACHMACSHA256ETM = "hmac-sha2-256-etm@openssh.com"
+	MACHMACSHA512ETM = "hmac-sha2-512-etm@openssh.com"
+)
+
+// Config は、クライアントとサーバーに共通する設定です。
+type Config struct {
+	// Rand は、鍵交換と署名に使用される乱数の源です。
+	// nil の場合、[crypto/rand.Reader] が使用されます。
+	Rand io.Reader
+
+	// RekeyThreshold は、鍵の再交換を行うまでに送信するバイト数です。
+	// ゼロの場合、暗号に応じた適切なデフォルト値が使用されます。
+	RekeyThreshold uint64
+
+	// KeyExchanges は、許可する鍵交換アルゴリズムを優先順に並べたものです。
+	// nil の場合、デフォルトのリストが使用されます。
+	KeyExchanges []string
+
+	// Ciphers は、許可する暗号アルゴリズムを優先順に並べたものです。
+	// nil の場合、デフォルトのリストが使用されます。
+	Ciphers []string
+
+	// MACs は、許可する MAC アルゴリズムを優先順に並べたものです。
+	// nil の場合、デフォルトのリストが使用されます。
+	// AEAD 暗号が選択された場合は使用されません。
+	MACs []string
+}
+
+// SetDefaults は、Config のゼロ値のフィールドにデフォルト値を設定します。
+func (c *Config) SetDefaults()
+
+// ConnMetadata は、SSH 接続に関する情報を保持します。
+type ConnMetadata interface {
+	// User は、クライアントが提示したユーザー名を返します。
+	User() string
+
+	// SessionID は、接続のセッション識別子を返します。
+	SessionID() []byte
+
+	// ClientVersion は、クライアントのバージョン文字列を返します。
+	ClientVersion() []byte
+
+	// ServerVersion は、サーバーのバージョン文字列を返します。
+	ServerVersion() []byte
+
+	// RemoteAddr は、接続の相手側のアドレスを返します。
+	RemoteAddr() net.Addr
+
+	// LocalAddr は、接続のこちら側のアドレスを返します。
+	LocalAddr() net.Addr
+}
+
+// Conn は、クライアントとサーバーに共通する SSH 接続を表します。
+type Conn interface {
+	ConnMetadata
+
+	// SendRequest は、グローバルリクエストを送信します。
+	// wantReply が true の場合、応答を待ってその結果を返します。
+	SendRequest(name string, wantReply bool, payload []byte) (bool, []byte, error)
+
+	// OpenChannel は、新しいチャネルを開きます。
+	// 相手がチャネルを拒否した場合、返されるエラーは [*OpenChannelError] です。
+	OpenChannel(name string, data []byte) (Channel, <-chan *Request, error)
+
+	// Close は、基礎となるネットワーク接続を閉じます。
+	Close() error
+
+	// Wait は、接続が閉じられるまでブロックし、その原因を返します。
+	Wait() error
+}
+
+// Channel は、SSH 接続上で多重化される双方向のデータストリームです。
+// Read と Write はチャネルのデータを、Stderr の Read と Write は
+// 拡張データ（タイプ 1）を扱います。
+type Channel interface {
+	Read(data []byte) (int, error)
+
+	Write(data []byte) (int, error)
+
+	// Close は、チャネルの両方向を閉じます。
+	Close() error
+
+	// CloseWrite は、チャネルの書き込み側を閉じ、相手に EOF を送信します。
+	CloseWrite() error
+
+	// SendRequest は、チャネルリクエストを送信します。
+	// wantReply が true の場合、応答を待ってその結果を返します。
+	SendRequest(name string, wantReply bool, payload []byte) (bool, error)
+
+	// Stderr は、拡張データ（標準エラー）を読み書きするための io.ReadWriter を返します。
+	Stderr() io.ReadWriter
+}
+
+// NewChannel は、相手から開かれようとしているチャネルを表します。
+// [NewChannel.Accept] または [NewChannel.Reject] のどちらかを呼び出さなければなりません。
+type NewChannel interface {
+	// Accept は、チャネルを受け入れます。
+	Accept() (Channel, <-chan *Request, error)
+
+	// Reject は、理由とメッセージを添えてチャネルを拒否します。
+	Reject(reason RejectionReason, message string) error
+
+	// ChannelType は、"session" や "direct-tcpip" のようなチャネルの種類を返します。
+	ChannelType() string
+
+	// ExtraData は、チャネルの種類に固有のデータを返します。
+	ExtraData() []byte
+}
+
+// Request は、グローバルリクエストまたはチャネルリクエストです。
+// WantReply が true の場合、[Request.Reply] を呼び出さなければなりません。
+type Request struct {
+	Type      string
+	WantReply bool
+	Payload   []byte
+
+	ch  *channel
+	mux *mux
+}
+
+// Reply は、リクエストに応答します。WantReply が false の場合は何もしません。
+// payload はグローバルリクエストの応答にのみ含まれます。
+func (r *Request) Reply(ok bool, payload []byte) error
+
+// DiscardRequests は、チャネルのすべてのリクエストに失敗として応答します。
+// チャネルが閉じられるまでブロックします。
+func DiscardRequests(in <-chan *Request)
+
+// RejectionReason は、チャネルが拒否された理由を示す RFC 4254 Section 5.1 のコードです。
+type RejectionReason uint32
+
+const (
+	Prohibited RejectionReason = iota + 1
+	ConnectionFailed
+	UnknownChannelType
+	ResourceShortage
+)
+
+func (r RejectionReason) String() string
+
+// OpenChannelError は、相手がチャネルを拒否した場合に返されます。
+type OpenChannelError struct {
+	Reason  RejectionReason
+	Message string
+}
+
+func (e *OpenChannelError) Error() string
+
+// ErrNoAuth は、すべての認証方法が失敗した場合に返されます。
+var ErrNoAuth = errors.New("ssh: no auth passed yet")
+
+// ServerAuthError は、サーバーがクライアントの認証を拒否した場合に
+// [NewServerConn] から返されます。Errors には各認証の試行のエラーが含まれます。
+type ServerAuthError struct {
+	Errors []error
+}
+
+func (e ServerAuthError) Error() string
@@ -0,0 +1,23 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssh
+
+import (
+	"github.com/shogo82148/std/context"
+	"github.com/shogo82148/std/net"
+)
+
+// Dial は、サーバーから指定されたアドレスに接続し（"direct-tcpip" チャネル、
+// ローカルポートフォワーディング）、その接続を [net.Conn] として返します。
+// network は "tcp"、"tcp4"、"tcp6"、または "unix" でなければなりません。
+func (c *Client) Dial(network, addr string) (net.Conn, error)
+
+// DialContext は [Client.Dial] と同様ですが、コンテキストを受け取ります。
+func (c *Client) DialContext(ctx context.Context, network, addr string) (net.Conn, error)
+
+// Listen は、サーバー側の指定されたアドレスでの待ち受けを要求し（"tcpip-forward"、
+// リモートポートフォワーディング）、転送された接続を受け入れる [net.Listener] を返します。
+// network は "tcp"、"tcp4"、"tcp6"、または "unix" でなければなりません。
+func (c *Client) Listen(network, addr string) (net.Listener, error)
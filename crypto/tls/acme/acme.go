@@ -0,0 +1,278 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// acmeパッケージは、[RFC 8555] で定義されたACME（Automatic Certificate Management
+// Environment）プロトコルのクライアントを提供します。
+//
+// ほとんどのアプリケーションはこのパッケージを直接使用する必要はなく、
+// [crypto/tls/acme/autocert] パッケージを使用して、[crypto/tls.Config.GetCertificate]
+// から証明書を自動的に取得・更新すべきです。
+//
+// サポートされるチャレンジは、http-01 と、[RFC 8737] で定義された tls-alpn-01 です。
+//
+// [RFC 8555]: https://www.rfc-editor.org/rfc/rfc8555.html
+// [RFC 8737]: https://www.rfc-editor.org/rfc/rfc8737.html
+package acme
+
+import (
+	"github.com/shogo82148/std/context"
+	"github.com/shogo82148/std/crypto"
+	"github.com/shogo82148/std/crypto/tls"
+	"github.com/shogo82148/std/errors"
+	"github.com/shogo82148/std/net/http"
+	"github.com/shogo82148/std/sync"
+	"github.com/shogo82148/std/time"
+)
+
+// LetsEncryptURL は、Let's Encrypt の本番環境の ACME ディレクトリの URL です。
+const LetsEncryptURL = "https://acme-v02.api.letsencrypt.org/directory"
+
+// ALPNProto は、tls-alpn-01 チャレンジの検証で使用される ALPN プロトコル名です。
+// チャレンジに応答する TLS サーバーは、[crypto/tls.Config.NextProtos] にこれを含める必要があります。
+const ALPNProto = "acme-tls/1"
+
+// ACME のリソースの状態です。
+const (
+	StatusDeactivated = "deactivated"
+	StatusExpired     = "expired"
+	StatusInvalid     = "invalid"
+	StatusPending     = "pending"
+	StatusProcessing  = "processing"
+	StatusReady       = "ready"
+	StatusRevoked     = "revoked"
+	StatusUnknown     = "unknown"
+	StatusValid       = "valid"
+)
+
+// ErrNoAccount は、クライアントの鍵に対応するアカウントがサーバーに存在しない場合に返されます。
+var ErrNoAccount = errors.New("acme: account does not exist")
+
+// ErrAccountAlreadyExists は、登録しようとしたアカウントがすでに存在する場合に
+// [Client.Register] から返されます。
+var ErrAccountAlreadyExists = errors.New("acme: account already exists")
+
+// Error は、RFC 7807 の問題の詳細としてサーバーから返された ACME のエラーです。
+type Error struct {
+	// StatusCode は HTTP のステータスコードです。
+	StatusCode int
+
+	// ProblemType は、"urn:ietf:params:acme:error:rateLimited" のような問題の種類を示す URI です。
+	ProblemType string
+
+	// Detail は人間が読める形式のエラーの説明です。
+	Detail string
+
+	// Subproblems は、個々の識別子に関するエラーです。
+	Subproblems []Subproblem
+
+	// Header は、エラーレスポンスの HTTP ヘッダーです。
+	// たとえば Retry-After を参照するために使用できます。
+	Header http.Header
+}
+
+func (e *Error) Error() string
+
+// Subproblem は、ACME のエラーに含まれる個々の識別子に関するエラーです。
+type Subproblem struct {
+	ProblemType string
+	Detail      string
+	Identifier  *AuthzID
+}
+
+// Directory は、ACME サーバーのディレクトリリソースです。
+type Directory struct {
+	NewNonceURL   string
+	NewAccountURL string
+	NewOrderURL   string
+	RevokeURL     string
+	KeyChangeURL  string
+
+	// Terms は、サーバーの利用規約の URL です。
+	Terms string
+
+	// Website は、サーバーの運営者の Web サイトの URL です。
+	Website string
+
+	// CAA は、サーバーが認識する CAA レコードのドメイン名です。
+	CAA []string
+
+	// ExternalAccountRequired は、アカウントの登録に外部アカウントの
+	// バインディングが必要かどうかを報告します。
+	ExternalAccountRequired bool
+}
+
+// Account は、ACME サーバー上のアカウントです。
+type Account struct {
+	// URI は、アカウントの URL です。サーバーによって割り当てられます。
+	URI string
+
+	// Contact は、"mailto:admin@example.com" のような連絡先の URL のリストです。
+	Contact []string
+
+	// Status は、アカウントの状態です。
+	Status string
+
+	// OrdersURL は、アカウントの注文のリストの URL です。
+	OrdersURL string
+
+	// ExternalAccountBinding が nil でない場合、登録時に外部アカウントとの
+	// バインディングを送信します。
+	ExternalAccountBinding *ExternalAccountBinding
+}
+
+// ExternalAccountBinding は、ACME アカウントを CA の既存のアカウントに関連付けるための
+// 鍵識別子と HMAC 鍵です。RFC 8555 Section 7.3.4 を参照してください。
+type ExternalAccountBinding struct {
+	KID string
+	Key []byte
+}
+
+// AuthzID は、証明書を要求する識別子です。
+type AuthzID struct {
+	// Type は識別子の種類で、"dns" または "ip" です。
+	Type string
+
+	// Value は、ドメイン名または IP アドレスです。
+	Value string
+}
+
+// DomainIDs は、指定されたドメイン名の "dns" 型の識別子のリストを返します。
+func DomainIDs(names ...string) []AuthzID
+
+// Order は、証明書の発行の要求です。
+type Order struct {
+	URI         string
+	Status      string
+	Expires     time.Time
+	Identifiers []AuthzID
+	NotBefore   time.Time
+	NotAfter    time.Time
+
+	// AuthzURLs は、注文の各識別子に対する認可の URL です。
+	AuthzURLs []string
+
+	// FinalizeURL は、すべての認可が有効になった後に CSR を送信する URL です。
+	FinalizeURL string
+
+	// CertURL は、発行された証明書の URL です。Status が [StatusValid] の場合にのみ設定されます。
+	CertURL string
+
+	// Error は、注文が [StatusInvalid] になった原因です。
+	Error *Error
+}
+
+// Authorization は、アカウントが識別子を制御していることの証明です。
+type Authorization struct {
+	URI        string
+	Status     string
+	Identifier AuthzID
+	Expires    time.Time
+	Wildcard   bool
+	Challenges []*Challenge
+}
+
+// Challenge は、識別子の制御を証明するためにサーバーが提示する課題です。
+type Challenge struct {
+	// Type は、"http-01" や "tls-alpn-01" のようなチャレンジの種類です。
+	Type      string
+	URI       string
+	Token     string
+	Status    string
+	Validated time.Time
+	Error     *Error
+}
+
+// Client は ACME クライアントです。
+// Client は複数のゴルーチンから同時に使用しても安全です。
+// ただし、使用を開始した後にフィールドを変更してはいけません。
+type Client struct {
+	// Key は、リクエストの署名に使用するアカウントの鍵です。
+	// *ecdsa.PrivateKey (P-256) と *rsa.PrivateKey がサポートされます。
+	Key crypto.Signer
+
+	// HTTPClient は、ACME サーバーへのリクエストに使用する HTTP クライアントです。
+	// nil の場合、[net/http.DefaultClient] が使用されます。
+	HTTPClient *http.Client
+
+	// DirectoryURL は、ACME サーバーのディレクトリの URL です。
+	// 空の場合、[LetsEncryptURL] が使用されます。
+	DirectoryURL string
+
+	// UserAgent は、User-Agent ヘッダーの先頭に付加される文字列です。
+	UserAgent string
+
+	// KID は、アカウントの URL です。空の場合、最初に必要になったときに
+	// サーバーに問い合わせて設定されます。
+	KID string
+
+	mu     sync.Mutex
+	dir    *Directory
+	nonces map[string]struct{}
+}
+
+// Discover は、ACME サーバーのディレクトリを取得して返します。
+// 結果はキャッシュされます。
+func (c *Client) Discover(ctx context.Context) (Directory, error)
+
+// Register は、c.Key を使用して新しいアカウントを登録します。
+// サーバーが利用規約を提示している場合、acceptTOS がその URL で呼び出され、
+// true を返した場合にのみ登録が行われます。
+// アカウントがすでに存在する場合、[ErrAccountAlreadyExists] を返します。
+func (c *Client) Register(ctx context.Context, acct *Account, acceptTOS func(tosURL string) bool) (*Account, error)
+
+// GetReg は、c.Key に対応する既存のアカウントを取得します。
+// アカウントが存在しない場合、[ErrNoAccount] を返します。
+func (c *Client) GetReg(ctx context.Context) (*Account, error)
+
+// UpdateReg は、アカウントの連絡先を更新します。
+func (c *Client) UpdateReg(ctx context.Context, acct *Account) (*Account, error)
+
+// DeactivateReg は、アカウントを無効化します。無効化されたアカウントは再び有効にできません。
+func (c *Client) DeactivateReg(ctx context.Context) error
+
+// AuthorizeOrder は、指定された識別子に対する証明書の新しい注文を作成します。
+func (c *Client) AuthorizeOrder(ctx context.Context, ids []AuthzID) (*Order, error)
+
+// GetOrder は、指定された URL の注文を取得します。
+func (c *Client) GetOrder(ctx context.Context, url string) (*Order, error)
+
+// GetAuthorization は、指定された URL の認可を取得します。
+func (c *Client) GetAuthorization(ctx context.Context, url string) (*Authorization, error)
+
+// Accept は、チャレンジへの応答の準備ができたことをサーバーに通知し、
+// サーバーに検証を開始させます。
+func (c *Client) Accept(ctx context.Context, chal *Challenge) (*Challenge, error)
+
+// WaitAuthorization は、認可が [StatusValid] または [StatusInvalid] になるまでポーリングします。
+// サーバーの Retry-After ヘッダーに従います。
+// 認可が無効になった場合、チャレンジのエラーを含む [*Error] を返します。
+func (c *Client) WaitAuthorization(ctx context.Context, url string) (*Authorization, error)
+
+// WaitOrder は、注文が [StatusReady]、[StatusValid]、または [StatusInvalid] になるまでポーリングします。
+func (c *Client) WaitOrder(ctx context.Context, url string) (*Order, error)
+
+// CreateOrderCert は、DER エンコードされた CSR を注文の FinalizeURL に送信し、
+// 証明書が発行されるのを待って、DER エンコードされた証明書チェーンを返します。
+// チェーンの最初の要素はリーフ証明書です。
+func (c *Client) CreateOrderCert(ctx context.Context, finalizeURL string, csr []byte) (der [][]byte, certURL string, err error)
+
+// FetchCert は、指定された URL の証明書チェーンを取得します。
+func (c *Client) FetchCert(ctx context.Context, url string) ([][]byte, error)
+
+// RevokeCert は、DER エンコードされた証明書を失効させます。
+// reason は RFC 5280 Section 5.3.1 で定義された失効理由です。
+// key が nil の場合、アカウントの鍵でリクエストに署名します。
+// それ以外の場合、証明書の秘密鍵である key で署名します。
+func (c *Client) RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason int) error
+
+// HTTP01ChallengePath は、http-01 チャレンジのレスポンスを提供すべき URL のパスを返します。
+func (c *Client) HTTP01ChallengePath(token string) string
+
+// HTTP01ChallengeResponse は、http-01 チャレンジに対して
+// [Client.HTTP01ChallengePath] で提供すべきレスポンスの本文を返します。
+func (c *Client) HTTP01ChallengeResponse(token string) (string, error)
+
+// TLSALPN01ChallengeCert は、tls-alpn-01 チャレンジに応答するための自己署名証明書を返します。
+// この証明書は、ALPN で [ALPNProto] が選択された TLS 接続で domain に対して提示されなければなりません。
+func (c *Client) TLSALPN01ChallengeCert(token, domain string) (tls.Certificate, error)
@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// acmetestパッケージは、ACMEクライアントのテストのためのユーティリティを提供します。
+// [net/http/httptest] と同じように、プロセス内でACMEサーバーを起動できます。
+//
+// このサーバーは [RFC 8555] のうち、アカウントの登録、注文、http-01 と tls-alpn-01 の
+// チャレンジの検証、および証明書の発行と失効を実装しています。
+// 発行される証明書は、サーバーごとに生成されるテスト用の CA によって署名されます。
+//
+// [RFC 8555]: https://www.rfc-editor.org/rfc/rfc8555.html
+package acmetest
+
+import (
+	"github.com/shogo82148/std/crypto"
+	"github.com/shogo82148/std/crypto/tls/acme"
+	"github.com/shogo82148/std/crypto/x509"
+	"github.com/shogo82148/std/net/http/httptest"
+	"github.com/shogo82148/std/sync"
+	"github.com/shogo82148/std/time"
+)
+
+// Server は、テスト用の ACME サーバーです。
+type Server struct {
+	// URL は、サーバーのディレクトリの URL です。
+	URL string
+
+	// HTTP01Addr が空でない場合、http-01 チャレンジの検証は、
+	// 識別子のドメイン名を解決する代わりに、このアドレスに接続して行われます。
+	// 通常は、[crypto/tls/acme/autocert.Manager.HTTPHandler] を提供するテストサーバーのアドレスを設定します。
+	HTTP01Addr string
+
+	// TLSALPN01Addr が空でない場合、tls-alpn-01 チャレンジの検証は、
+	// 識別子のドメイン名を解決する代わりに、このアドレスに接続して行われます。
+	TLSALPN01Addr string
+
+	// SkipValidation が true の場合、チャレンジは検証されずに常に有効になります。
+	SkipValidation bool
+
+	// CertLifetime は、発行される証明書の有効期間です。
+	// ゼロの場合、90 日が使用されます。
+	// 更新の振る舞いをテストするために、短い値を設定できます。
+	CertLifetime time.Duration
+
+	ts       *httptest.Server
+	caKey    crypto.Signer
+	caCert   *x509.Certificate
+	mu       sync.Mutex
+	accounts map[string]*account
+	orders   map[string]*order
+	authzs   map[string]*authorization
+	certs    map[string][]byte
+	revoked  map[string]int
+}
+
+// NewServer は、新しい ACME サーバーを起動して返します。
+// 呼び出し元は、使用後に Close を呼び出す必要があります。
+func NewServer() *Server
+
+// Close は、サーバーを停止します。
+func (s *Server) Close()
+
+// Roots は、サーバーが発行する証明書を検証するためのルート証明書のプールを返します。
+func (s *Server) Roots() *x509.CertPool
+
+// Client は、key をアカウントの鍵として使用し、このサーバーと通信するように設定された
+// [acme.Client] を返します。返されるクライアントは、サーバーの自己署名の
+// TLS 証明書を信頼する HTTP クライアントを使用します。
+func (s *Server) Client(key crypto.Signer) *acme.Client
+
+// Revoked は、DER エンコードされた証明書 cert がサーバーによって失効されているかどうかと、
+// その失効理由を報告します。
+func (s *Server) Revoked(cert []byte) (reason int, ok bool)
@@ -0,0 +1,150 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// autocertパッケージは、ACMEによるTLS証明書の自動的な取得と更新を提供します。
+// 証明書は有効期限が切れる前に更新されます。
+//
+// [Manager.GetCertificate] を [crypto/tls.Config.GetCertificate] に設定すると、
+// 新しいドメインへの最初の TLS ハンドシェイクで証明書が取得され、
+// 以後はキャッシュから提供されます。
+//
+// ドメインの制御は、tls-alpn-01 チャレンジ（TLS ハンドシェイクの中で
+// [Manager.GetCertificate] が応答します）または http-01 チャレンジ
+// （[Manager.HTTPHandler] が応答します）で証明されます。
+// http-01 チャレンジを使用するには、ポート 80 で [Manager.HTTPHandler] を提供する必要があります。
+package autocert
+
+import (
+	"github.com/shogo82148/std/context"
+	"github.com/shogo82148/std/crypto/tls"
+	"github.com/shogo82148/std/crypto/tls/acme"
+	"github.com/shogo82148/std/errors"
+	"github.com/shogo82148/std/net/http"
+	"github.com/shogo82148/std/sync"
+	"github.com/shogo82148/std/time"
+)
+
+// DefaultRenewBefore は、[Manager.RenewBefore] がゼロの場合に使用される、
+// 証明書の有効期限のどれだけ前に更新を開始するかのデフォルト値です。
+const DefaultRenewBefore = 30 * 24 * time.Hour
+
+// ErrCacheMiss は、キーに対応するデータがキャッシュに存在しない場合に
+// [Cache.Get] から返されます。
+var ErrCacheMiss = errors.New("autocert: certificate cache miss")
+
+// Cache は、[Manager] が証明書とアカウントの鍵を保存するために使用するストレージです。
+// Cache の実装は、複数のゴルーチンから同時に使用しても安全でなければなりません。
+//
+// キャッシュされるデータには秘密鍵が含まれるため、実装は適切にアクセスを制限すべきです。
+type Cache interface {
+	// Get は、キーに対応するデータを返します。
+	// データが存在しない場合、ErrCacheMiss を返します。
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Put は、キーに対応するデータを保存します。
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Delete は、キーに対応するデータを削除します。
+	// データが存在しない場合もエラーにはなりません。
+	Delete(ctx context.Context, key string) error
+}
+
+// DirCache は、ローカルファイルシステムのディレクトリにデータを保存する [Cache] です。
+// ディレクトリが存在しない場合は、パーミッション 0700 で作成されます。
+// ファイルはパーミッション 0600 で書き込まれます。
+type DirCache string
+
+func (d DirCache) Get(ctx context.Context, key string) ([]byte, error)
+
+func (d DirCache) Put(ctx context.Context, key string, data []byte) error
+
+func (d DirCache) Delete(ctx context.Context, key string) error
+
+// HostPolicy は、[Manager] が指定されたホストの証明書を取得してよいかどうかを判断します。
+// 証明書を取得すべきでない場合は、エラーを返します。
+type HostPolicy func(ctx context.Context, host string) error
+
+// AllowHosts は、指定されたホスト名に対してのみ証明書を取得する [HostPolicy] を返します。
+// ホスト名の比較は、IDNA の Punycode に変換した後に大文字小文字を区別せずに行われます。
+func AllowHosts(hosts ...string) HostPolicy
+
+// AcceptTOS は常に true を返し、ACME サーバーの利用規約に同意します。
+// [Manager.Prompt] に設定して使用します。
+func AcceptTOS(tosURL string) bool
+
+// Manager は、ACME を使用して証明書を取得し、キャッシュし、更新する
+// [crypto/tls.Config.GetCertificate] の実装です。
+//
+// ゼロ値の Manager は使用できませんが、Prompt を設定するだけで使用できるようになります。
+// Manager は複数のゴルーチンから同時に使用しても安全です。
+// 使用を開始した後にフィールドを変更してはいけません。
+type Manager struct {
+	// Prompt は、ACME サーバーの利用規約に同意するかどうかを判断します。
+	// アカウントの登録時に利用規約の URL で呼び出されます。
+	// 通常は [AcceptTOS] が設定されます。
+	Prompt func(tosURL string) bool
+
+	// Cache が nil でない場合、証明書とアカウントの鍵の保存に使用されます。
+	// nil の場合、証明書はメモリ上にのみ保持され、プロセスの再起動のたびに
+	// 再取得されるため、ACME サーバーのレート制限に達する可能性があります。
+	Cache Cache
+
+	// HostPolicy は、証明書を取得してよいホストを制御します。
+	// nil の場合、任意のホストの証明書の取得が試みられます。
+	// 攻撃者が任意の SNI を送信してレート制限を消費させることを防ぐため、
+	// HostPolicy を設定することを強く推奨します。
+	HostPolicy HostPolicy
+
+	// RenewBefore は、証明書の有効期限のどれだけ前に更新を開始するかです。
+	// ゼロの場合、[DefaultRenewBefore] が使用されます。
+	// 更新はバックグラウンドで行われ、失敗した場合は有効期限まで再試行されます。
+	RenewBefore time.Duration
+
+	// Client は、ACME サーバーとの通信に使用されます。
+	// nil の場合、[acme.LetsEncryptURL] を使用するクライアントが作成されます。
+	// Client.Key が nil の場合、アカウントの鍵が生成されて Cache に保存されます。
+	Client *acme.Client
+
+	// Email は、アカウントの登録時に連絡先として使用されるメールアドレスです。
+	// 空でもかまいません。
+	Email string
+
+	// ExternalAccountBinding は、CA がそれを要求する場合にアカウントの登録時に使用されます。
+	ExternalAccountBinding *acme.ExternalAccountBinding
+
+	// DisableHTTP01 と DisableTLSALPN01 は、それぞれのチャレンジの使用を無効にします。
+	DisableHTTP01    bool
+	DisableTLSALPN01 bool
+
+	clientMu sync.Mutex
+	client   *acme.Client
+
+	stateMu sync.Mutex
+	state   map[string]*certState
+
+	renewalMu sync.Mutex
+	renewal   map[string]*domainRenewal
+
+	challengeMu sync.RWMutex
+	httpTokens  map[string][]byte
+	certTokens  map[string]*tls.Certificate
+}
+
+// GetCertificate は、[crypto/tls.Config.GetCertificate] で使用するためのメソッドです。
+// hello.ServerName に対する証明書を、メモリ、Cache、ACME サーバーの順に探して返します。
+// 証明書の取得中は、同じ名前に対する他のハンドシェイクは取得の完了を待ちます。
+//
+// hello が tls-alpn-01 チャレンジの検証のためのもの（ALPN で [acme.ALPNProto] が提示されている）場合、
+// チャレンジ用の証明書を返します。
+func (m *Manager) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error)
+
+// HTTPHandler は、http-01 チャレンジに応答する HTTP ハンドラーを返します。
+// "/.well-known/acme-challenge/" 以外のパスへのリクエストは fallback に渡されます。
+// fallback が nil の場合、GET と HEAD のリクエストを HTTPS にリダイレクトし、
+// それ以外のリクエストには 400 Bad Request を返すハンドラーが使用されます。
+func (m *Manager) HTTPHandler(fallback http.Handler) http.Handler
+
+// TLSConfig は、GetCertificate を設定し、NextProtos に "h2"、"http/1.1"、
+// および [acme.ALPNProto] を含む [crypto/tls.Config] を返します。
+func (m *Manager) TLSConfig() *tls.Config
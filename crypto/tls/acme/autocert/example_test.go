@@ -0,0 +1,35 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autocert_test
+
+import (
+	"github.com/shogo82148/std/crypto/tls/acme/autocert"
+	"github.com/shogo82148/std/fmt"
+	"github.com/shogo82148/std/log"
+	"github.com/shogo82148/std/net/http"
+)
+
+func ExampleManager() {
+	m := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		Cache:      autocert.DirCache("secret-dir"),
+		HostPolicy: autocert.AllowHosts("example.org", "www.example.org"),
+		Email:      "admin@example.org",
+	}
+
+	// http-01 チャレンジに応答し、それ以外のリクエストを HTTPS にリダイレクトします。
+	go func() {
+		log.Fatal(http.ListenAndServe(":http", m.HTTPHandler(nil)))
+	}()
+
+	s := &http.Server{
+		Addr:      ":https",
+		TLSConfig: m.TLSConfig(),
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, "Hello, TLS user! Your config: %+v", r.TLS)
+		}),
+	}
+	log.Fatal(s.ListenAndServeTLS("", ""))
+}
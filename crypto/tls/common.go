@@ -336,6 +336,9 @@ type Config struct {
 	// NameToCertificateがnilの場合、Certificatesの最良の要素が使用されます。
 	//
 	// 一度証明書が返されたら、変更しないでください。
+	//
+	// ACMEを使って証明書を自動的に取得・更新するには、
+	// [crypto/tls/acme/autocert.Manager.GetCertificate] を使用してください。
 	GetCertificate func(*ClientHelloInfo) (*Certificate, error)
 
 	// GetClientCertificateは、クライアントが証明書を要求する場合に呼び出されます。
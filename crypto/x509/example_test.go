@@ -5,6 +5,8 @@
 package x509_test
 
 import (
+	"github.com/shogo82148/std/bytes"
+	"github.com/shogo82148/std/context"
 	"github.com/shogo82148/std/crypto/dsa"
 	"github.com/shogo82148/std/crypto/ecdsa"
 	"github.com/shogo82148/std/crypto/ed25519"
@@ -12,7 +14,11 @@ import (
 	"github.com/shogo82148/std/crypto/rsa"
 	"github.com/shogo82148/std/crypto/x509"
 	"github.com/shogo82148/std/encoding/pem"
+	"github.com/shogo82148/std/errors"
 	"github.com/shogo82148/std/fmt"
+	"github.com/shogo82148/std/io"
+	"github.com/shogo82148/std/net/http"
+	"github.com/shogo82148/std/os"
 )
 
 func ExampleCertificate_Verify() {
@@ -170,3 +176,49 @@ func ExampleMarshalPKCS8PrivateKey_mlDSA() {
 		panic("round-tripped key does not match original")
 	}
 }
+
+func ExampleRevocationChecker() {
+	// 検証する証明書を読み込みます。
+	certPEM, err := os.ReadFile("cert.pem")
+	if err != nil {
+		panic(err)
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		panic("failed to parse certificate PEM")
+	}
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		panic("failed to parse certificate: " + err.Error())
+	}
+
+	checker := &x509.RevocationChecker{
+		// OCSPレスポンダーにHTTP POSTでリクエストを送信します。
+		FetchOCSP: func(ctx context.Context, server string, request []byte) ([]byte, error) {
+			req, err := http.NewRequestWithContext(ctx, "POST", server, bytes.NewReader(request))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Content-Type", "application/ocsp-request")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+			return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		},
+		// 失効状態を確認できない証明書は拒否します。
+		Policy: x509.RevocationHardFail,
+	}
+
+	// Rootsがnilのため、システムのルート証明書が使用されます。
+	_, err = leaf.Verify(x509.VerifyOptions{
+		DNSName:           "www.example.com",
+		RevocationChecker: checker,
+	})
+	var revoked *x509.RevokedError
+	if errors.As(err, &revoked) {
+		fmt.Printf("certificate %d in the chain was revoked at %v (reason %d)\n",
+			revoked.Index, revoked.RevokedAt, revoked.ReasonCode)
+	}
+}
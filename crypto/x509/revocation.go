@@ -0,0 +1,137 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x509
+
+import (
+	"github.com/shogo82148/std/context"
+	"github.com/shogo82148/std/time"
+)
+
+// RevocationPolicyは、証明書の失効状態を判断できなかった場合の
+// [RevocationChecker] の振る舞いを指定します。
+type RevocationPolicy int
+
+const (
+	// RevocationSoftFailは、失効状態を判断できなかった証明書を失効していないものとして扱います。
+	// 証明書が失効していることが確認された場合にのみ、検証は失敗します。
+	RevocationSoftFail RevocationPolicy = iota
+
+	// RevocationHardFailは、失効状態を判断できなかった証明書を含むチェーンを拒否します。
+	RevocationHardFail
+)
+
+// RevocationSourceは、失効状態がどこから得られたかを示します。
+type RevocationSource int
+
+const (
+	// RevocationSourceCRLは、証明書失効リスト（CRL）から得られた失効状態を示します。
+	RevocationSourceCRL RevocationSource = iota + 1
+
+	// RevocationSourceOCSPは、OCSPレスポンスから得られた失効状態を示します。
+	RevocationSourceOCSP
+)
+
+func (s RevocationSource) String() string
+
+// RevocationCheckerは、[Certificate.Verify] が構築したチェーンの各証明書について、
+// RFC 5280で定義されたCRLとRFC 6960で定義されたOCSPを使って失効状態を確認します。
+//
+// チェーン内のルート以外の各証明書について、RevocationCheckerは次の順序で
+// 失効状態を確認し、最初に得られた結果を使用します。
+//
+//  1. リーフ証明書については、OCSPStapleのOCSPレスポンス
+//  2. CRLsのうち、証明書の発行者が署名したもの
+//  3. FetchOCSPがnilでない場合、証明書のOCSPServerから取得したOCSPレスポンス
+//  4. FetchCRLがnilでない場合、証明書のCRLDistributionPointsから取得したCRL
+//
+// OCSPレスポンスは、証明書の発行者、または発行者が [ExtKeyUsageOCSPSigning] を持つ証明書で
+// 委任したレスポンダーによって署名されていなければならず、
+// [VerifyOptions.CurrentTime] の時点で有効でなければなりません。
+// CRLは発行者によって署名され、NextUpdateを過ぎていてはいけません。
+// これらの条件を満たさないレスポンスやCRLは無視されます。
+//
+// 失効した証明書を含むチェーンは、[Certificate.Verify] の結果から取り除かれます。
+// すべてのチェーンが取り除かれた場合、Verifyは [*RevokedError] を返します。
+// Policyが [RevocationHardFail] で、失効状態を判断できなかった証明書がある場合、
+// そのチェーンも取り除かれ、すべてのチェーンが取り除かれた場合は
+// [*RevocationUnknownError] が返されます。
+//
+// RevocationCheckerは、[VerifyOptions] 間で共有でき、複数のゴルーチンから
+// 同時に使用しても安全です。ただし、使用中にフィールドを変更してはいけません。
+type RevocationChecker struct {
+	// CRLsは、失効の確認に使用する事前に取得したCRLのリストです。
+	CRLs []*RevocationList
+
+	// OCSPStapleは、リーフ証明書に対するDERエンコードされたOCSPレスポンスです。
+	// 通常、TLSハンドシェイクでステープルされたレスポンス
+	// （[crypto/tls.ConnectionState.OCSPResponse]）が設定されます。
+	OCSPStaple []byte
+
+	// FetchOCSPがnilでない場合、OCSPレスポンダーからレスポンスを取得するために呼び出されます。
+	// serverは証明書のOCSPServerのURLの1つで、requestはDERエンコードされたOCSPリクエストです。
+	// FetchOCSPはDERエンコードされたOCSPレスポンスを返さなければなりません。
+	// 通常は、[net/http] を使ってrequestをContent-Typeが"application/ocsp-request"の
+	// POSTリクエストとして送信します。テストでは、ローカルに用意したレスポンスを返す
+	// 関数を使用できます。
+	FetchOCSP func(ctx context.Context, server string, request []byte) ([]byte, error)
+
+	// FetchCRLがnilでない場合、証明書のCRLDistributionPointsのURLからCRLを取得するために
+	// 呼び出されます。FetchCRLはDERエンコードされたCRLを返さなければなりません。
+	FetchCRL func(ctx context.Context, url string) ([]byte, error)
+
+	// Timeoutは、1回の [Certificate.Verify] の呼び出しでFetchOCSPとFetchCRLの呼び出しに
+	// 費やす時間の上限です。ゼロの場合、10秒が使用されます。
+	Timeout time.Duration
+
+	// Policyは、失効状態を判断できなかった場合の振る舞いを指定します。
+	Policy RevocationPolicy
+
+	// LeafOnlyがtrueの場合、リーフ証明書の失効状態のみを確認します。
+	LeafOnly bool
+}
+
+// RevokedErrorは、チェーン内の証明書が失効していた場合に [Certificate.Verify] から返されます。
+type RevokedError struct {
+	// Certは失効した証明書です。
+	Cert *Certificate
+
+	// Chainは、Certを含む、失効により拒否されたチェーンです。
+	Chain []*Certificate
+
+	// Indexは、Chain内のCertの位置です。リーフ証明書は0です。
+	Index int
+
+	// ReasonCodeは、RFC 5280 Section 5.3.1で定義された失効理由です。
+	// CRLまたはOCSPレスポンスが理由を含まない場合、0（unspecified）です。
+	ReasonCode int
+
+	// RevokedAtは、証明書が失効した時刻です。
+	RevokedAt time.Time
+
+	// Sourceは、失効が確認された情報源です。
+	Source RevocationSource
+}
+
+func (e *RevokedError) Error() string
+
+// RevocationUnknownErrorは、[RevocationHardFail] ポリシーの下で、チェーン内の
+// 証明書の失効状態を判断できなかった場合に [Certificate.Verify] から返されます。
+type RevocationUnknownError struct {
+	// Certは失効状態を判断できなかった証明書です。
+	Cert *Certificate
+
+	// Chainは、Certを含む、拒否されたチェーンです。
+	Chain []*Certificate
+
+	// Indexは、Chain内のCertの位置です。リーフ証明書は0です。
+	Index int
+
+	// Errは、失効状態の取得に失敗した原因です。利用可能な情報源がなかった場合はnilです。
+	Err error
+}
+
+func (e *RevocationUnknownError) Error() string
+
+func (e *RevocationUnknownError) Unwrap() error
@@ -113,6 +113,11 @@ type VerifyOptions struct {
 	// field implies any valid policy is acceptable.
 	CertificatePolicies []OID
 
+	// RevocationCheckerがnilでない場合、構築された各チェーンの証明書の失効状態を
+	// CRLとOCSPを使って確認します。詳細は [RevocationChecker] を参照してください。
+	// RevocationCheckerは、プラットフォームの検証器が使用される場合にも適用されます。
+	RevocationChecker *RevocationChecker
+
 	// inhibitPolicyMapping indicates if policy mapping should be allowed
 	// during path validation.
 	inhibitPolicyMapping bool
@@ -155,7 +160,10 @@ type VerifyOptions struct {
 //
 // 返されるチェーン内のc以外の証明書は変更すべきではありません。
 //
-// 警告：この関数は失効チェックを実行しません。
+// opts.RevocationCheckerがnilの場合、この関数は失効チェックを実行しません。
+// RevocationCheckerがnilでない場合、失効した証明書を含むチェーンは返されず、
+// 有効なチェーンが残らない場合は [*RevokedError] または
+// [*RevocationUnknownError] が返されます。
 func (c *Certificate) Verify(opts VerifyOptions) ([][]*Certificate, error)
 
 // VerifyHostnameは指定されたホストに対して、cが有効な証明書であればnilを返します。
@@ -360,3 +360,47 @@ func ExampleRows() {
 	}
 	log.Printf("%s are %d years old", strings.Join(names, ", "), age)
 }
+
+func ExampleDB_SetObserver() {
+	// 遅いクエリをログに記録します。
+	db.SetObserver(sql.ObserverFunc(func(ctx context.Context, e *sql.Event) {
+		if e.Duration > 100*time.Millisecond {
+			log.Printf("slow %v (%v): %s", e.Kind, e.Duration, e.Query)
+		}
+		if e.Err != nil {
+			log.Printf("%v failed: %v", e.Kind, e.Err)
+		}
+	}))
+}
+
+func ExampleScanRows() {
+	type User struct {
+		ID   int64  `sql:"id"`
+		Name string `sql:"name"`
+		Age  int
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT id, name, age FROM users WHERE age > ?", 20)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// ScanRowsは、イテレーションの終了時にrowsを閉じます。
+	for u, err := range sql.ScanRows[User](rows) {
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%d: %s is %d\n", u.ID, u.Name, u.Age)
+	}
+}
+
+func ExampleQueryOne() {
+	name, err := sql.QueryOne[string](ctx, db, "SELECT name FROM users WHERE id = ?", 123)
+	switch {
+	case err == sql.ErrNoRows:
+		log.Printf("no user with id %d\n", 123)
+	case err != nil:
+		log.Fatal(err)
+	default:
+		log.Printf("username is %q\n", name)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"github.com/shogo82148/std/context"
+	"github.com/shogo82148/std/time"
+)
+
+// EventKindは、[Event] が表す操作の種類です。
+type EventKind int
+
+const (
+	// EventConnWaitは、プールから接続を取得するための待機を表します。
+	// 待機せずに接続を取得できた場合は報告されません。
+	EventConnWait EventKind = iota + 1
+	// EventConnOpenは、ドライバーによる新しい接続の確立を表します。
+	EventConnOpen
+	// EventPingは、PingまたはPingContextの呼び出しを表します。
+	EventPing
+	// EventPrepareは、ステートメントの準備を表します。
+	EventPrepare
+	// EventExecは、結果の行を返さないクエリの実行を表します。
+	EventExec
+	// EventQueryは、行を返すクエリの実行を表します。
+	// 時間は [*Rows] が返されるまでを計測し、行の読み取りは含みません。
+	EventQuery
+	// EventBeginは、トランザクションの開始を表します。
+	EventBegin
+	// EventCommitは、トランザクションのコミットを表します。
+	EventCommit
+	// EventRollbackは、トランザクションのロールバックを表します。
+	EventRollback
+)
+
+func (k EventKind) String() string
+
+// Eventは、[Observer] に報告される単一の操作を記述します。
+type Event struct {
+	Kind EventKind
+
+	// Queryは、EventPrepare、EventExec、EventQueryのクエリ文字列です。
+	// プリペアドステートメントの実行では、準備されたクエリ文字列になります。
+	Query string
+
+	// Argsは、EventExecとEventQueryの引数で、呼び出し元が渡した値そのものです。
+	// Observerは、Argsを変更したり、報告の後まで保持したりしてはいけません。
+	Args []any
+
+	// InTxは、操作がトランザクション内で実行された場合にtrueです。
+	InTx bool
+
+	// Startは操作の開始時刻、Durationは操作にかかった時間です。
+	Start    time.Time
+	Duration time.Duration
+
+	// Errは、操作が失敗した場合のエラーです。
+	Err error
+}
+
+// Observerは、[DB] で実行された操作の通知を受け取ります。
+//
+// Observeは、操作が完了した後、その操作を実行したゴルーチンから同期的に呼び出されます。
+// ctxは操作に渡されたコンテキストであり、トレースのスパンなどの情報を取り出すために使用できます。
+// Observeは複数のゴルーチンから同時に呼び出される可能性があり、
+// 操作の完了を遅らせないようにすばやく戻る必要があります。
+// eはObserveが戻った後に再利用される可能性があるため、保持してはいけません。
+type Observer interface {
+	Observe(ctx context.Context, e *Event)
+}
+
+// ObserverFunc型は、通常の関数を [Observer] として使用するためのアダプタです。
+type ObserverFunc func(ctx context.Context, e *Event)
+
+// Observeはf(ctx, e)を呼び出します。
+func (f ObserverFunc) Observe(ctx context.Context, e *Event)
+
+// SetObserverは、dbとdbから作成された [Conn]、[Tx]、[Stmt] で実行される操作を
+// 報告する [Observer] を設定します。
+// oがnilの場合、報告は行われません。
+//
+// SetObserverは、他のメソッドと同時に呼び出すことができ、以降に開始される操作に適用されます。
+func (db *DB) SetObserver(o Observer)
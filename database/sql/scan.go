@@ -0,0 +1,56 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"github.com/shogo82148/std/context"
+	"github.com/shogo82148/std/iter"
+)
+
+// Queryerは、[DB]、[Conn]、[Tx] によって実装される、行を返すクエリを実行するインターフェースです。
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*Rows, error)
+}
+
+// ScanRowsは、rowsの各行をT型の値にスキャンするイテレータを返します。
+// イテレーションが終了するか中断されると、rowsは閉じられます。
+// 行のスキャンに失敗した場合、または [Rows.Err] がエラーを報告した場合、
+// イテレータはゼロ値とエラーを生成し、終了します。
+//
+// Tが構造体型の場合、後述する単一列の型を除き、各列は列名と一致する名前を持つエクスポートされたフィールドにスキャンされます。
+// フィールドの列名は、構造体フィールドのタグの"sql"キーで指定できます。
+//
+//	// 列"user_id"にスキャンされます。
+//	ID int64 `sql:"user_id"`
+//
+//	// このフィールドは無視されます。
+//	Cache []byte `sql:"-"`
+//
+// タグを持たないフィールドは、大文字と小文字を区別せずにフィールド名と一致する列に対応します。
+// 埋め込まれた構造体のフィールドは、外側の構造体のフィールドであるかのように扱われます。
+// どのフィールドにも対応しない列がある場合、エラーが報告されます。
+// 次の場合、クエリはちょうど1つの列を返す必要があり、その列はTに直接スキャンされます。
+//   - *Tが [Scanner] を実装している場合（[NullString] や [Null] など）
+//   - Tが [time.Time] など、構造体型であっても [Rows.Scan] が
+//     直接変換できる型である場合
+//   - Tが構造体型でない場合
+//
+// 各値の変換には [Rows.Scan] と同じ規則が使用されるため、
+// NULLの扱い、[Scanner] 、および [driver.Valuer] の動作は [Rows.Scan] と同じです。
+// [RawBytes] 型のフィールドはサポートされていません。
+func ScanRows[T any](rows *Rows) iter.Seq2[T, error]
+
+// ScanRowは、[ScanRows] と同じ規則を使用してrowをT型の値にスキャンします。
+// rowに行がない場合、ScanRowは [ErrNoRows] を返します。
+func ScanRow[T any](row *Row) (T, error)
+
+// QueryOneは、qで行を返すクエリを実行し、最初の行を [ScanRows] と同じ規則を使用して
+// T型の値にスキャンします。残りの行は破棄されます。
+// クエリが行を返さない場合、QueryOneは [ErrNoRows] を返します。
+func QueryOne[T any](ctx context.Context, q Queryer, query string, args ...any) (T, error)
+
+// QueryAllは、qで行を返すクエリを実行し、すべての行を [ScanRows] と同じ規則を使用して
+// T型の値のスライスにスキャンします。
+func QueryAll[T any](ctx context.Context, q Queryer, query string, args ...any) ([]T, error)
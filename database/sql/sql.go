@@ -315,6 +315,9 @@ type DB struct {
 	maxIdleTimeClosed int64
 	maxLifetimeClosed int64
 
+	// observer holds the Observer set by SetObserver, if any.
+	observer atomic.Pointer[observerHolder]
+
 	stop func()
 }
 
@@ -0,0 +1,93 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csv
+
+import (
+	"github.com/shogo82148/std/reflect"
+)
+
+// Unmarshalは、ヘッダー行を持つCSVエンコードされたデータを解析し、
+// 各レコードをvが指す構造体のスライスの要素に格納します。
+// vは構造体または構造体へのポインタのスライスへのnilでないポインタでなければなりません。
+// そうでない場合、Unmarshalは [InvalidUnmarshalError] を返します。
+//
+// 列と構造体フィールドの対応付け、および値の変換規則については [Decoder.Decode] を参照してください。
+func Unmarshal(data []byte, v any) error
+
+// Decoderは、ヘッダー行の列名を使用してCSVのレコードを構造体にデコードします。
+type Decoder struct {
+	r *Reader
+
+	header    []string
+	readHdr   bool
+	disallow  bool
+	fieldsFor map[reflect.Type][][]int
+}
+
+// NewDecoderは、rから読み取る新しいデコーダーを返します。
+// 区切り文字などのrのオプションは、最初の [Decoder.Decode] の前に設定する必要があります。
+// rの [Reader.ReuseRecord] と [Reader.FieldsPerRecord] の設定はそのまま使用されます。
+func NewDecoder(r *Reader) *Decoder
+
+// SetHeaderは、列名として使用するヘッダーを設定します。
+// SetHeaderが最初の [Decoder.Decode] の前に呼び出された場合、
+// 入力の最初のレコードはヘッダーではなくデータとして扱われます。
+func (d *Decoder) SetHeader(header []string)
+
+// Headerは、列名として使用されるヘッダーを返します。
+// ヘッダーがまだ読み取られていない場合、Headerは入力から1つのレコードを読み取ります。
+func (d *Decoder) Header() ([]string, error)
+
+// DisallowUnknownFieldsは、どの構造体フィールドにも対応しない列がヘッダーにある場合に、
+// [Decoder.Decode] がエラーを返すようにします。
+func (d *Decoder) DisallowUnknownFields()
+
+// Decodeは、入力から次のレコードを読み取り、vが指す構造体に格納します。
+// 読み取るレコードがない場合、Decodeは [io.EOF] を返します。
+//
+// 各列は、ヘッダーの列名と一致する名前を持つエクスポートされたフィールドに格納されます。
+// フィールドの名前は、構造体フィールドのタグの"csv"キーで指定できます。
+//
+//	// 列"name"に対応し、ゼロ値の場合はエンコード時に空の値を書き込みます。
+//	// 空の値はデコード時にゼロ値になります。
+//	Name string `csv:"name,omitempty"`
+//
+//	// このフィールドは無視されます。
+//	Internal string `csv:"-"`
+//
+// タグを持たないフィールドは、大文字と小文字を区別せずにフィールド名と一致する列に対応します。
+// 埋め込まれた構造体のフィールドは、外側の構造体のフィールドであるかのように扱われます。
+// 対応する列がないフィールドは変更されません。
+//
+// 値は次のように変換されます。
+// 空の値は、フィールドの型にかかわらずゼロ値として格納されます。
+// ポインタ型のフィールドはnilに設定されます。
+// そのため、omitemptyオプションでエンコードされたレコードは元の値にデコードされます。
+// 空でない値について、[encoding.TextUnmarshaler] を実装する型にはUnmarshalTextが使用されます。
+// 文字列、真偽値、整数、浮動小数点数は [strconv] の関数で解析されます。
+// ポインタ型のフィールドには新しい値が割り当てられます。
+//
+// 値を変換できない場合、Decodeは、[UnmarshalTypeError] をErrとして持つ
+// [ParseError] を返し、問題のある値の行と列を報告します。
+func (d *Decoder) Decode(v any) error
+
+// UnmarshalTypeErrorは、特定のGo型の値に対して適切でないCSVの値を説明します。
+type UnmarshalTypeError struct {
+	Value  string
+	Type   reflect.Type
+	Column string
+	Err    error
+}
+
+func (e *UnmarshalTypeError) Error() string
+
+func (e *UnmarshalTypeError) Unwrap() error
+
+// InvalidUnmarshalErrorは、[Unmarshal] または [Decoder.Decode] に渡された無効な引数を説明します。
+type InvalidUnmarshalError struct {
+	Type reflect.Type
+}
+
+func (e *InvalidUnmarshalError) Error() string
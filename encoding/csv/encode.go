@@ -0,0 +1,56 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csv
+
+import (
+	"github.com/shogo82148/std/reflect"
+)
+
+// Marshalは、vのCSVエンコーディングを返します。
+// vは構造体または構造体へのポインタのスライスでなければなりません。
+// 出力は、構造体フィールドから得られるヘッダー行と、要素ごとに1つのレコードで構成されます。
+//
+// 値の書き込み規則については [Encoder.Encode] を参照してください。
+func Marshal(v any) ([]byte, error)
+
+// Encoderは、構造体をCSVのレコードとしてエンコードします。
+type Encoder struct {
+	w *Writer
+
+	header      []string
+	wroteHeader bool
+	noHeader    bool
+	typ         reflect.Type
+	fields      [][]int
+}
+
+// NewEncoderは、wに書き込む新しいエンコーダーを返します。
+// 書き込みはwでバッファリングされるため、呼び出し元は最後に
+// [Writer.Flush] を呼び出して [Writer.Error] を確認する必要があります。
+func NewEncoder(w *Writer) *Encoder
+
+// OmitHeaderは、[Encoder.Encode] がヘッダー行を書き込まないようにします。
+// 最初の [Encoder.Encode] の前に呼び出す必要があります。
+func (e *Encoder) OmitHeader()
+
+// Encodeは、構造体または構造体へのポインタvを1つのレコードとして書き込みます。
+// 最初の呼び出しでは、レコードの前にヘッダー行が書き込まれます。
+// 同じエンコーダーでエンコードされる値はすべて同じ型でなければなりません。
+//
+// 列は、[Decoder.Decode] と同じ規則で構造体フィールドから得られ、
+// フィールドの宣言順に並びます。
+// [encoding.TextMarshaler] を実装する値にはMarshalTextが使用されます。
+// 文字列、真偽値、整数、浮動小数点数は [strconv] の関数で書式化されます。
+// nilポインタと、omitemptyオプションを持つゼロ値のフィールドは空の値として書き込まれます。
+// それ以外の型のフィールドでは、Encodeは [UnsupportedTypeError] を返します。
+func (e *Encoder) Encode(v any) error
+
+// UnsupportedTypeErrorは、サポートされていない値の型をエンコードしようとしたときに、
+// [Marshal] と [Encoder.Encode] によって返されます。
+type UnsupportedTypeError struct {
+	Type reflect.Type
+}
+
+func (e *UnsupportedTypeError) Error() string
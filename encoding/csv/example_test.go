@@ -129,3 +129,63 @@ func ExampleWriter_WriteAll() {
 	// Ken,Thompson,ken
 	// Robert,Griesemer,gri
 }
+
+func ExampleDecoder() {
+	in := `first_name,last_name,username
+"Rob","Pike",rob
+Ken,Thompson,ken
+"Robert","Griesemer","gri"
+`
+	type User struct {
+		FirstName string `csv:"first_name"`
+		LastName  string `csv:"last_name"`
+		Username  string `csv:"username"`
+	}
+
+	d := csv.NewDecoder(csv.NewReader(strings.NewReader(in)))
+	for {
+		var u User
+		err := d.Decode(&u)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s %s (%s)\n", u.FirstName, u.LastName, u.Username)
+	}
+	// Output:
+	// Rob Pike (rob)
+	// Ken Thompson (ken)
+	// Robert Griesemer (gri)
+}
+
+func ExampleEncoder() {
+	type User struct {
+		FirstName string `csv:"first_name"`
+		LastName  string `csv:"last_name"`
+		Username  string `csv:"username,omitempty"`
+	}
+	users := []User{
+		{"Rob", "Pike", "rob"},
+		{"Ken", "Thompson", ""},
+	}
+
+	w := csv.NewWriter(os.Stdout)
+	e := csv.NewEncoder(w)
+	for _, u := range users {
+		if err := e.Encode(u); err != nil {
+			log.Fatalln("error encoding user to csv:", err)
+		}
+	}
+
+	// バッファリングされたデータを基礎となるライターに書き込みます。
+	w.Flush()
+	if err := w.Error(); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// first_name,last_name,username
+	// Rob,Pike,rob
+	// Ken,Thompson,
+}
@@ -557,3 +557,55 @@ func ExampleWithUnmarshalers_recordOffsets() {
 	// Output:
 	// 3:3: source and destination must both be specified
 }
+
+// Schemaは、Marshalが生成するJSONを記述するJSON Schemaを返します。
+// スキーマは構造体のタグとオプションから導出されるため、実際の出力と常に一致します。
+func ExampleSchemaFor() {
+	type User struct {
+		Name   string            `json:"name"`
+		Age    int               `json:"age,omitzero"`
+		Emails []string          `json:"emails,omitempty"`
+		Extra  map[string]string `json:",unknown"`
+	}
+
+	schema, err := json.SchemaFor[User](json.StringifyNumbers(true))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := schema.Indent(); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(schema))
+
+	// Output:
+	// {
+	// 	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	// 	"$ref": "#/$defs/User",
+	// 	"$defs": {
+	// 		"User": {
+	// 			"type": "object",
+	// 			"properties": {
+	// 				"name": {
+	// 					"type": "string"
+	// 				},
+	// 				"age": {
+	// 					"type": "string",
+	// 					"pattern": "^-?(0|[1-9][0-9]*)$"
+	// 				},
+	// 				"emails": {
+	// 					"type": "array",
+	// 					"items": {
+	// 						"type": "string"
+	// 					}
+	// 				}
+	// 			},
+	// 			"required": [
+	// 				"name"
+	// 			],
+	// 			"additionalProperties": {
+	// 				"type": "string"
+	// 			}
+	// 		}
+	// 	}
+	// }
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build goexperiment.jsonv2
+
+package json
+
+import (
+	"github.com/shogo82148/std/reflect"
+
+	"github.com/shogo82148/std/encoding/json/jsontext"
+)
+
+// SchemaMarshalerは、自身のJSON表現を記述するJSON Schemaを返すことができる型によって
+// 実装されるインターフェースです。
+// MarshalJSONやMarshalJSONToでデフォルトの表現を上書きする型は、
+// 生成されるスキーマを正確に保つためにこのインターフェースを実装すべきです。
+//
+// MarshalJSONSchemaは、JSON Schemaを表す単一のJSON値を返さなければなりません。
+// "$ref"で参照される定義は、返されたスキーマ内に自己完結していなければなりません。
+type SchemaMarshaler interface {
+	MarshalJSONSchema() ([]byte, error)
+}
+
+// Schemaは、指定されたマーシャルオプションに従って [Marshal] がt型の値に対して
+// 生成するJSONを記述する、JSON Schema（draft 2020-12）のドキュメントを返します。
+// アンマーシャルオプションは無視されます。
+//
+// スキーマは [Marshal] と同じ規則で導出されます:
+//
+//   - [WithMarshalers] オプション内の型固有関数が型に一致する場合、または
+//     型が [MarshalerTo] や [Marshaler] を実装している場合、その型の表現は不明であるため、
+//     型が [SchemaMarshaler] を実装していない限り、任意のJSON値を受け入れる空のスキーマになります。
+//
+//   - [encoding.TextAppender] または [encoding.TextMarshaler] を実装する型は、
+//     "type":"string"になります。
+//
+//   - 整数と浮動小数点数は"integer"と"number"になります。
+//     [StringifyNumbers] オプションまたは`string`タグオプションが指定されている場合は、
+//     数値の形式を表す"pattern"を持つ"string"になります。
+//
+//   - []byteと[N]byteは、"contentEncoding":"base64"を持つ"string"になります。
+//
+//   - [time.Time] は"format":"date-time"を持つ"string"になります。
+//     `format`タグオプションが指定されている場合は、その形式に従います。
+//
+//   - Goの構造体は"object"になり、各フィールドは"properties"に宣言順で列挙されます。
+//     `omitzero`または`omitempty`タグオプションを持たないフィールドは"required"に列挙されます。
+//     [OmitZeroStructFields] オプションが指定されている場合、どのフィールドも必須になりません。
+//     インライン化されたフィールドは外側のオブジェクトに展開されます。
+//     `unknown`タグオプションを持つフィールドは"additionalProperties"になり、
+//     そうでない場合、"additionalProperties"はfalseになります。
+//
+//   - マップは、値の型のスキーマを"additionalProperties"として持つ"object"になり、
+//     スライスと配列は"items"を持つ"array"になります。
+//     [FormatNilSliceAsNull] または [FormatNilMapAsNull] オプションが指定されている場合、
+//     "null"も許可されます。
+//
+//   - ポインタとインターフェースの型は、基底の型のスキーマに加えて"null"を許可します。
+//     any型は空のスキーマになります。
+//
+// 名前付きの構造体型は"$defs"に一度だけ定義され、"$ref"で参照されるため、
+// 再帰的な型も表現できます。
+//
+// JSON表現を持たない型（複素数、チャネル、関数など）を含む場合、
+// Schemaは [SemanticError] を返します。
+func Schema(t reflect.Type, opts ...Options) (jsontext.Value, error)
+
+// SchemaForは、T型に対する [Schema] の結果を返します。
+func SchemaFor[T any](opts ...Options) (jsontext.Value, error)
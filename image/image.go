@@ -9,7 +9,7 @@
 //
 // Values of the [Image] interface are created either by calling functions such
 // as [NewRGBA] and [NewPaletted], or by calling [Decode] on an [io.Reader] containing
-// image data in a format such as GIF, JPEG or PNG. Decoding any particular
+// image data in a format such as GIF, JPEG, PNG or WebP. Decoding any particular
 // image format requires the prior registration of a decoder function.
 // Registration is typically automatic as a side effect of initializing that
 // format's package so that, to decode a PNG image, it suffices to have
@@ -5,11 +5,27 @@
 // pngパッケージは、PNG画像のデコーダとエンコーダを実装します。
 //
 // PNGの仕様は https://www.w3.org/TR/PNG/ にあります。
+// アニメーションPNG（APNG）のacTL、fcTL、fdATチャンクは、
+// [DecodeAll] と [EncodeAll] でサポートされます。
 package png
 
 import (
 	"github.com/shogo82148/std/image"
 	"github.com/shogo82148/std/io"
+	"github.com/shogo82148/std/time"
+)
+
+// フレームの廃棄方法。値はfcTLチャンクのdispose_opフィールドの値です。
+const (
+	DisposeNone       = 0x00
+	DisposeBackground = 0x01
+	DisposePrevious   = 0x02
+)
+
+// フレームのブレンド方法。値はfcTLチャンクのblend_opフィールドの値です。
+const (
+	BlendSource = 0x00
+	BlendOver   = 0x01
 )
 
 // FormatErrorは、入力が有効なPNGではないことを報告します。
@@ -24,7 +40,48 @@ func (e UnsupportedError) Error() string
 
 // Decodeは、rからPNG画像を読み取り、それを [image.Image] として返します。
 // 返されるImageの型は、PNGの内容に依存します。
+// アニメーションPNGの場合、Decodeはデフォルト画像を返し、アニメーションのフレームは無視されます。
 func Decode(r io.Reader) (image.Image, error)
 
 // DecodeConfigは、画像全体をデコードすることなく、PNG画像のカラーモデルと寸法を返します。
 func DecodeConfig(r io.Reader) (image.Config, error)
+
+// APNGは、アニメーションPNGファイルに保存されている複数のフレームを表します。
+type APNG struct {
+	// Imageは、連続したフレームです。各フレームの範囲は、
+	// キャンバス上のフレームの位置を表し、二つの点 (0, 0) と
+	// (Config.Width, Config.Height) で定義される矩形内になければなりません。
+	Image []image.Image
+	// Delayは、フレームごとの連続した表示時間です。
+	// fcTLチャンクは表示時間を16ビットの分子と分母からなる秒数で格納するため、
+	// エンコード時、正確に表現できない値は最も近い分数に丸められます。
+	Delay []time.Duration
+	// Disposeは、フレームごとの連続した廃棄方法です。
+	// nil Disposeは、すべてのフレームが [DisposeNone] であることを意味します。
+	Dispose []byte
+	// Blendは、フレームごとの連続したブレンド方法です。
+	// nil Blendは、すべてのフレームが [BlendSource] であることを意味します。
+	Blend []byte
+	// LoopCountは、アニメーションを繰り返す回数です。
+	// LoopCountが0の場合、無限にループします。
+	LoopCount int
+	// Defaultは、アニメーションに対応していないデコーダが表示するデフォルト画像です。
+	// Defaultがnilの場合、最初のフレームがデフォルト画像を兼ねます。
+	// そうでない場合、Defaultはアニメーションの一部ではなく、
+	// その範囲はキャンバス全体と一致しなければなりません。
+	Default image.Image
+	// Configは、キャンバスのカラーモデルと幅、高さです。
+	//
+	// ゼロ値のConfigは、キャンバスの幅と高さが
+	// 最初のフレームの範囲のRectangle.Max点と等しいことを意味します。
+	Config image.Config
+}
+
+// DecodeAllは、rからPNG画像を読み取り、連続したフレームとタイミング情報を返します。
+// アニメーションでない画像の場合は、1つのフレームを持つ [APNG] を返します。
+//
+// フレームは合成されずに、ファイルに格納されている通りに返されます。
+// DecodeAllはすべてのデコードされたフレームをメモリに留めます。
+// 信頼できない入力の場合、最初に [DecodeConfig] を呼び出して
+// 画像サイズを検証してください。
+func DecodeAll(r io.Reader) (*APNG, error)
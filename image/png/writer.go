@@ -50,3 +50,16 @@ func Encode(w io.Writer, m image.Image) error
 // wに書き込まれた正確なバイト数はGo 1の互換性保証の対象外です。
 // テストを含む呼び出し元は、正確に書き込まれたバイト数に依存してはいけません。
 func (enc *Encoder) Encode(w io.Writer, m image.Image) error
+
+// EncodeAllは、aのフレームをアニメーションPNGとしてwに書き込みます。
+// すべてのフレームは、同じカラーモデルでエンコードされます。
+//
+// wに書き込まれた正確なバイト数はGo 1の互換性保証の対象外です。
+// テストを含む呼び出し元は、正確に書き込まれたバイト数に依存してはいけません。
+func EncodeAll(w io.Writer, a *APNG) error
+
+// EncodeAllは、aのフレームをアニメーションPNGとしてwに書き込みます。
+//
+// wに書き込まれた正確なバイト数はGo 1の互換性保証の対象外です。
+// テストを含む呼び出し元は、正確に書き込まれたバイト数に依存してはいけません。
+func (enc *Encoder) EncodeAll(w io.Writer, a *APNG) error
@@ -0,0 +1,97 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// webpパッケージは、WebP画像のデコーダを実装します。
+//
+// VP8による非可逆圧縮の画像、VP8Lによる可逆圧縮の画像、
+// ALPHチャンクによるアルファチャンネル、およびアニメーション画像をサポートします。
+//
+// WebP仕様は https://developers.google.com/speed/webp/docs/riff_container にあります。
+// 可逆圧縮のビットストリームはRFC 9649で、非可逆圧縮のビットストリームはRFC 6386で定義されています。
+//
+// 信頼できない入力をデコードする場合は、[Decode] または [DecodeAll] を呼び出す前に
+// [DecodeConfig] でディメンションを読み取ってください。これらの関数と [image] パッケージ
+// ドキュメントの「Security Considerations」セクションを参照してください。
+package webp
+
+import (
+	"github.com/shogo82148/std/image"
+	"github.com/shogo82148/std/image/color"
+	"github.com/shogo82148/std/io"
+	"github.com/shogo82148/std/time"
+)
+
+// フレームの廃棄方法。
+// DisposeNoneはキャンバスをそのまま残し、
+// DisposeBackgroundはフレームの領域を背景色で塗りつぶします。
+const (
+	DisposeNone       = 0x00
+	DisposeBackground = 0x01
+)
+
+// フレームのブレンド方法。
+// BlendSourceはフレームでキャンバスの領域を置き換え、
+// BlendOverはフレームをキャンバスにアルファブレンドします。
+const (
+	BlendSource = 0x00
+	BlendOver   = 0x01
+)
+
+// FormatErrorは、入力が有効なWebPではないことを報告します。
+type FormatError string
+
+func (e FormatError) Error() string
+
+// UnsupportedErrorは、入力が有効だが実装されていないWebPの機能を使用していることを報告します。
+type UnsupportedError string
+
+func (e UnsupportedError) Error() string
+
+// DecodeはrからWebP画像を読み取り、[image.Image] として返します。
+// アニメーション画像の場合は、最初のフレームを返します。
+//
+// 非可逆圧縮の画像は、アルファチャンネルがない場合は [*image.YCbCr] として、
+// ある場合は [*image.NYCbCrA] として返されます。
+// 可逆圧縮の画像は [*image.NRGBA] として返されます。
+//
+// 信頼できないソースから画像をデコードする場合、
+// 最初に [DecodeConfig] を呼び出して画像サイズをチェックしてください。
+func Decode(r io.Reader) (image.Image, error)
+
+// DecodeConfigは、WebP画像全体をデコードせずに、カラーモデルとディメンションを返します。
+// アニメーション画像の場合は、キャンバスのディメンションを返します。
+func DecodeConfig(r io.Reader) (image.Config, error)
+
+// WebPは、アニメーションWebPファイルに保存されている複数のフレームを表します。
+type WebP struct {
+	// Imageは、連続したフレームです。各フレームの範囲は、
+	// キャンバス上のフレームの位置を表し、二つの点 (0, 0) と
+	// (Config.Width, Config.Height) で定義される矩形内にあります。
+	Image []image.Image
+	// Delayは、フレームごとの連続した表示時間です。
+	// ANMFチャンクの表示時間はミリ秒単位で格納されています。
+	Delay []time.Duration
+	// Disposeは、フレームごとの連続した廃棄方法です。
+	Dispose []byte
+	// Blendは、フレームごとの連続したブレンド方法です。
+	Blend []byte
+	// LoopCountは、アニメーションを繰り返す回数です。
+	// LoopCountが0の場合、無限にループします。
+	LoopCount int
+	// BackgroundColorは、キャンバスの背景色です。
+	// 表示する側はこの値を無視してもかまいません。
+	BackgroundColor color.NRGBA
+	// Configは、キャンバスのカラーモデルと幅、高さです。
+	Config image.Config
+}
+
+// DecodeAllはrからWebP画像を読み取り、連続したフレームと
+// タイミング情報を返します。
+// アニメーションでない画像の場合は、1つのフレームを持つ [WebP] を返します。
+//
+// フレームは合成されずに、ファイルに格納されている通りに返されます。
+// [DecodeAll] はすべてのデコードされたフレームをメモリに留めます。
+// 信頼できない入力の場合、最初に [DecodeConfig] を呼び出して
+// キャンバスのサイズを検証し、過大なメモリを要求する入力を拒否してください。
+func DecodeAll(r io.Reader) (*WebP, error)
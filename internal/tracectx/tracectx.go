@@ -0,0 +1,21 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tracectx holds the context key shared by the
+// net/http/tracecontext and log/slog packages for W3C trace context.
+// It lets log/slog read the trace context without depending on
+// net/http. This package is purely internal and has no stable API
+// exposed to end users.
+package tracectx
+
+// Key is a context.Context Value key. Its associated value should
+// be a Value.
+type Key struct{}
+
+// Value is the trace context stored in a context.Context.
+type Value struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+	Flags   byte
+}
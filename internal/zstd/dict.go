@@ -0,0 +1,31 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+// Dict is a zstd dictionary: either a dictionary in the format
+// described in RFC 8878 section 5, or a raw content dictionary.
+// A Dict is immutable once parsed.
+type Dict struct {
+	// ID is the dictionary ID, or 0 for a raw content dictionary.
+	ID uint32
+
+	// Content is the dictionary content that precedes the data.
+	Content []byte
+
+	// RepeatedOffsets are the initial repeated offsets.
+	RepeatedOffsets [3]uint32
+
+	// Entropy tables; empty for a raw content dictionary.
+	huffmanTable     []uint16
+	huffmanTableBits int
+	seqTables        [3][]fseBaselineEntry
+	seqTableBits     [3]uint8
+}
+
+// ParseDict parses b as a zstd dictionary.
+// If b starts with the dictionary magic number it must be a well-formed
+// dictionary; otherwise b is treated as a raw content dictionary.
+// The returned Dict does not retain b.
+func ParseDict(b []byte) (*Dict, error)
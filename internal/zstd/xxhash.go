@@ -3,3 +3,21 @@
 // license that can be found in the LICENSE file.
 
 package zstd
+
+// XXHash64 computes the 64-bit xxhash used for zstd content checksums.
+// The zero value is ready to use with a seed of 0.
+type XXHash64 struct {
+	len uint64
+	v   [4]uint64
+	buf [32]byte
+	cnt int
+}
+
+// Reset discards the current state.
+func (xh *XXHash64) Reset()
+
+// Update adds b to the hash.
+func (xh *XXHash64) Update(b []byte)
+
+// Digest returns the hash of the data added so far.
+func (xh *XXHash64) Digest() uint64
@@ -3,13 +3,40 @@
 // license that can be found in the LICENSE file.
 
 // Package zstd provides a decompressor for zstd streams,
-// described in RFC 8878. It does not support dictionaries.
+// described in RFC 8878. It is the decoder used by compress/zstd.
 package zstd
 
 import (
+	"github.com/shogo82148/std/errors"
 	"github.com/shogo82148/std/io"
 )
 
+var (
+	// ErrChecksum is wrapped by the error returned for a frame
+	// whose content checksum does not match.
+	ErrChecksum = errors.New("invalid checksum")
+
+	// ErrDictionary is wrapped by the error returned for a frame
+	// that refers to a dictionary that was not provided.
+	ErrDictionary = errors.New("unknown dictionary")
+
+	// ErrWindowSize is wrapped by the error returned for a frame
+	// whose window size exceeds the configured limit.
+	ErrWindowSize = errors.New("window size exceeds limit")
+)
+
+// Error is the error returned by Reader for malformed input.
+type Error struct {
+	// Offset is the offset in the compressed input at which
+	// the error was detected.
+	Offset int64
+	Err    error
+}
+
+func (e *Error) Error() string
+
+func (e *Error) Unwrap() error
+
 // Reader implements [io.Reader] to read a zstd compressed stream.
 type Reader struct {
 	// The underlying Reader.
@@ -74,7 +101,15 @@ type Reader struct {
 	fseScratch []fseEntry
 
 	// For checksum computation.
-	checksum xxhash64
+	checksum XXHash64
+
+	// Dictionaries looked up by the dictionary ID in the frame header.
+	dicts map[uint32]*Dict
+	// Raw content dictionary used for frames without a dictionary ID.
+	rawDict *Dict
+
+	// The largest window size accepted; 0 means the default.
+	maxWindow uint64
 }
 
 // NewReader creates a new Reader that decompresses data from the given reader.
@@ -82,8 +117,19 @@ func NewReader(input io.Reader) *Reader
 
 // Reset discards the current state and starts reading a new stream from r.
 // This permits reusing a Reader rather than allocating a new one.
+// The dictionaries and window size limit are retained.
 func (r *Reader) Reset(input io.Reader)
 
+// SetDicts sets the dictionaries used to decompress frames that refer to one.
+// A dictionary with ID 0 is used for frames that have no dictionary ID.
+// SetDicts must be called before the first Read.
+func (r *Reader) SetDicts(dicts ...*Dict)
+
+// SetMaxWindowSize sets the largest window size that r accepts.
+// Frames that require a larger window fail with an error wrapping
+// [ErrWindowSize]. SetMaxWindowSize must be called before the first Read.
+func (r *Reader) SetMaxWindowSize(n uint64)
+
 // Read implements [io.Reader].
 func (r *Reader) Read(p []byte) (int, error)
 
@@ -0,0 +1,36 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build unix
+
+package slog_test
+
+import (
+	"github.com/shogo82148/std/log/slog"
+	"github.com/shogo82148/std/os"
+	"github.com/shogo82148/std/syscall"
+	"github.com/shogo82148/std/time"
+)
+
+func ExampleRotatingFile() {
+	// 100 MiBごと、または1日ごとにローテーションし、
+	// 圧縮した古いファイルを7個まで保持します。
+	f, err := slog.OpenRotatingFile("/var/log/app/app.log", &slog.RotateOptions{
+		MaxSize:    100 << 20,
+		Interval:   24 * time.Hour,
+		MaxBackups: 7,
+		Compress:   true,
+	})
+	if err != nil {
+		slog.Error("open log file", "err", err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	// logrotateなどの外部のツールと併用する場合は、SIGHUPでファイルを開き直します。
+	f.ReopenOnSignal(syscall.SIGHUP)
+
+	logger := slog.New(slog.NewJSONHandler(f, nil))
+	logger.Info("started", "pid", os.Getpid())
+}
@@ -0,0 +1,32 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package slog_test
+
+import (
+	"github.com/shogo82148/std/errors"
+	"github.com/shogo82148/std/log/slog"
+	"github.com/shogo82148/std/os"
+	"github.com/shogo82148/std/time"
+)
+
+func ExampleSamplingHandler() {
+	// 各レベルで1秒あたり最初の3件を出力し、その後は100件ごとに1件を出力します。
+	// 同じメッセージと属性キーを持つレコードは、10秒間に1件だけ出力します。
+	h := slog.NewSamplingHandler(slog.NewTextHandler(os.Stderr, nil), &slog.SamplingOptions{
+		Tick:        time.Second,
+		First:       3,
+		Thereafter:  100,
+		DedupWindow: 10 * time.Second,
+	})
+	logger := slog.New(h)
+
+	err := errors.New("connection refused")
+	for range 1000 {
+		logger.Error("dial failed", "err", err)
+	}
+
+	stats := h.Stats()
+	logger.Info("sampling", "passed", stats.Passed, "dropped", stats.Sampled+stats.Deduplicated)
+}
@@ -72,6 +72,13 @@ type HandlerOptions struct {
 	// 型を変換したり（例えば、`time.Time`をUnixエポックからの整数秒で置き換える）、
 	// 個人情報をサニタイズしたり、出力から属性を削除するために使用できます。
 	ReplaceAttr func(groups []string, a Attr) Attr
+
+	// AddTraceContextは、Handleに渡されたコンテキストがW3Cのトレースコンテキストを
+	// 保持している場合に、ハンドラに出力へTraceIDKeyとSpanIDKeyの属性を追加させます。
+	// トレースコンテキストは、[net/http/tracecontext.WithTraceParent] によって
+	// コンテキストに格納されます。
+	// これらの属性は、グループの外側でmsgの直後に出力されます。
+	AddTraceContext bool
 }
 
 // "built-in"属性のキー。
@@ -91,6 +98,14 @@ const (
 	// SourceKey は、ログ呼び出しのソースファイルと行のためにビルトインハンドラによって使用されるキーです。
 	// 関連する値は *[Source] です。
 	SourceKey = "source"
+	// TraceIDKeyは、AddTraceContextオプションが設定されている場合に、
+	// コンテキストのトレースIDのために組み込みハンドラによって使用されるキーです。
+	// 関連する値は、32文字の16進数の文字列です。
+	TraceIDKey = "trace_id"
+	// SpanIDKeyは、AddTraceContextオプションが設定されている場合に、
+	// コンテキストのスパンIDのために組み込みハンドラによって使用されるキーです。
+	// 関連する値は、16文字の16進数の文字列です。
+	SpanIDKey = "span_id"
 )
 
 // DiscardHandler discards all log output.
@@ -53,5 +53,8 @@ func (h *JSONHandler) WithGroup(name string) Handler
 // 2つ目は、エンコードの失敗がHandleからエラーを返すことはありません。
 // 代わりに、エラーメッセージが文字列としてフォーマットされます。
 //
+// AddTraceContextオプションが設定されており、ctxがトレースコンテキストを保持している場合、
+// "trace_id"と"span_id"のキーが16進数の文字列の値とともに出力されます。
+//
 // Handleの各呼び出しは、io.Writer.Writeに対して1回のシリアル化された呼び出しを生成します。
-func (h *JSONHandler) Handle(_ context.Context, r Record) error
+func (h *JSONHandler) Handle(ctx context.Context, r Record) error
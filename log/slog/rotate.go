@@ -0,0 +1,102 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package slog
+
+import (
+	"github.com/shogo82148/std/io/fs"
+	"github.com/shogo82148/std/os"
+	"github.com/shogo82148/std/sync"
+	"github.com/shogo82148/std/time"
+)
+
+// RotateOptionsは、[RotatingFile] のローテーションと保持のオプションです。
+// ゼロ値のRotateOptionsは、ローテーションを行わず、古いファイルを削除しません。
+type RotateOptions struct {
+	// MaxSizeが正の場合、書き込みによってファイルのサイズがMaxSizeバイトを超えるときに、
+	// 書き込みの前にファイルがローテーションされます。
+	// 1回の書き込みがMaxSizeより大きい場合、そのレコードは分割されずに新しいファイルに書き込まれます。
+	MaxSize int64
+
+	// Intervalが正の場合、ファイルを開いた後に最初にローテーションの境界を過ぎてからの
+	// 最初の書き込みの前に、ファイルがローテーションされます。
+	// 境界は壁時計の時刻から計算され、UTC（LocalTimeがtrueの場合はローカル時刻）の
+	// 各日の0時からIntervalの倍数だけ経過した時刻です。
+	// たとえば24*time.Hourは日付が変わるごとに、time.Hourは毎正時にローテーションします。
+	// Intervalは24時間以下で、24時間を割り切る値でなければなりません。
+	// そうでない場合、[OpenRotatingFile] はエラーを返します。
+	Interval time.Duration
+
+	// MaxBackupsが正の場合、ローテーションされたファイルのうち最も新しいMaxBackups個だけが保持され、
+	// 古いファイルは削除されます。
+	MaxBackups int
+
+	// MaxAgeが正の場合、ローテーションされてからMaxAgeより長く経過したファイルは削除されます。
+	MaxAge time.Duration
+
+	// Compressがtrueの場合、ローテーションされたファイルは [compress/gzip] で圧縮され、
+	// 名前に".gz"が追加されます。圧縮はバックグラウンドで行われます。
+	Compress bool
+
+	// Permは、新しく作成されるファイルのパーミッションです。
+	// ゼロの場合、0644が使用されます。
+	Perm fs.FileMode
+
+	// LocalTimeがtrueの場合、ローテーションされたファイルの名前とIntervalの境界の計算に
+	// ローカル時刻の壁時計が使用されます。そうでない場合はUTCが使用されます。
+	// ローカル時刻の場合、夏時間の切り替えがある日の境界は、その日の壁時計に従います。
+	LocalTime bool
+}
+
+// RotatingFileは、ファイルに書き込み、サイズまたは時間に基づいてそれをローテーションする
+// [io.WriteCloser] です。
+// [NewJSONHandler] や [NewTextHandler] の出力先として使用することを目的としています。
+//
+// ローテーションでは、現在のファイルの名前が、nameの拡張子の前にタイムスタンプを挿入した
+// 名前（たとえば"app.log"は"app-2006-01-02T15-04-05.000.log"）に変更され、
+// nameで新しいファイルが作成されます。
+//
+// RotatingFileのメソッドは、複数のゴルーチンから同時に呼び出しても安全です。
+// 各Writeは、ローテーションによって分割されることはありません。
+type RotatingFile struct {
+	name string
+	opts RotateOptions
+
+	mu       sync.Mutex
+	f        *os.File
+	size     int64
+	openedAt time.Time
+	next     time.Time
+	closed   bool
+
+	// sigc receives the signals registered by ReopenOnSignal.
+	sigc chan os.Signal
+	// millc is used to request deletion and compression of old files.
+	millc chan struct{}
+	wg    sync.WaitGroup
+}
+
+// OpenRotatingFileは、nameのファイルを追記モードで開き（存在しない場合は作成し）、
+// optsに従ってローテーションする [RotatingFile] を返します。
+// optsがnilの場合、ゼロ値のRotateOptionsが使用されます。
+func OpenRotatingFile(name string, opts *RotateOptions) (*RotatingFile, error)
+
+// Writeは [io.Writer] を実装します。
+// 必要に応じて、pを書き込む前にファイルをローテーションします。
+func (f *RotatingFile) Write(p []byte) (n int, err error)
+
+// Rotateは、ローテーションの条件にかかわらず、直ちにファイルをローテーションします。
+func (f *RotatingFile) Rotate() error
+
+// Reopenは、現在のファイルを閉じて、nameのファイルを再び開きます。
+// これは、logrotateなどの外部のツールがファイルを移動した後に使用することを目的としています。
+func (f *RotatingFile) Reopen() error
+
+// ReopenOnSignalは、指定されたシグナルのいずれかを受信したときに [RotatingFile.Reopen] を
+// 呼び出すようにします。Unixシステムでは、通常 [syscall.SIGHUP] が使用されます。
+// シグナルの受信は、[RotatingFile.Close] で停止されます。
+func (f *RotatingFile) ReopenOnSignal(sig ...os.Signal)
+
+// Closeは、シグナルの受信とバックグラウンドの処理を停止し、現在のファイルを閉じます。
+func (f *RotatingFile) Close() error
@@ -0,0 +1,72 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package slog
+
+import (
+	"github.com/shogo82148/std/context"
+	"github.com/shogo82148/std/time"
+)
+
+// SamplingOptionsは、[SamplingHandler] のオプションです。
+// ゼロ値のSamplingOptionsは、レコードを破棄しません。
+type SamplingOptions struct {
+	// Tickは、サンプリングのカウンタがリセットされる間隔です。
+	// ゼロの場合、1秒が使用されます。
+	Tick time.Duration
+
+	// FirstとThereafterは、レベルごとのサンプリングを制御します。
+	// Firstが正の場合、各Tickの間、各レベルの最初のFirst個のレコードが出力されます。
+	// その後は、Thereafterが正であればThereafter個ごとに1つのレコードが出力され、
+	// そうでなければTickの終わりまでそのレベルのレコードはすべて破棄されます。
+	First      int
+	Thereafter int
+
+	// Levelsが空でない場合、サンプリングはLevelsに含まれるレベルのレコードにのみ適用されます。
+	// 空の場合、すべてのレベルに適用されます。
+	Levels []Level
+
+	// DedupWindowが正の場合、同じレベル、メッセージ、および同じ属性キーの集合を持つレコードは、
+	// 最初のレコードからDedupWindowの間、重複として破棄されます。
+	// 属性の値は比較されません。
+	DedupWindow time.Duration
+}
+
+// SamplingStatsは、[SamplingHandler] が処理したレコードの数を報告します。
+type SamplingStats struct {
+	// Passedは、ラップされたハンドラに渡されたレコードの数です。
+	Passed uint64
+	// Sampledは、レベルごとのサンプリングによって破棄されたレコードの数です。
+	Sampled uint64
+	// Deduplicatedは、重複として破棄されたレコードの数です。
+	Deduplicated uint64
+}
+
+// SamplingHandlerは、ログの量を制限する [Handler] です。
+// レコードをサンプリングし、重複を取り除いてから、ラップされたハンドラに渡します。
+//
+// WithAttrsとWithGroupが返すハンドラは、元のSamplingHandlerとカウンタを共有します。
+// 重複の判定には、WithAttrsで追加された属性は含まれません。
+type SamplingHandler struct {
+	h     Handler
+	state *samplingState
+}
+
+// NewSamplingHandlerは、optsに従ってレコードを間引いてからhに渡す [SamplingHandler] を返します。
+// optsがnilの場合、ゼロ値のSamplingOptionsが使用されます。
+func NewSamplingHandler(h Handler, opts *SamplingOptions) *SamplingHandler
+
+// Enabledは、ラップされたハンドラのEnabledの結果を返します。
+func (h *SamplingHandler) Enabled(ctx context.Context, l Level) bool
+
+// Handleは、rを破棄すべきでない場合に、ラップされたハンドラのHandleを呼び出します。
+// 破棄されたレコードについては、nilを返します。
+func (h *SamplingHandler) Handle(ctx context.Context, r Record) error
+
+func (h *SamplingHandler) WithAttrs(attrs []Attr) Handler
+
+func (h *SamplingHandler) WithGroup(name string) Handler
+
+// Statsは、hが作成されてから処理したレコードの数を返します。
+func (h *SamplingHandler) Stats() SamplingStats
@@ -39,6 +39,9 @@ func (h *TextHandler) WithGroup(name string) Handler
 //
 // メッセージのkeyは"msg"です。
 //
+// AddTraceContextオプションが設定されており、ctxがトレースコンテキストを保持している場合、
+// "trace_id"と"span_id"のキーが16進数の値とともに出力されます。
+//
 // これらまたは他の属性を変更したり、出力から削除するには、
 // [HandlerOptions.ReplaceAttr] を使用します。
 //
@@ -55,4 +58,4 @@ func (h *TextHandler) WithGroup(name string) Handler
 // [HandlerOptions.ReplaceAttr] を使用して、その情報をキーにエンコードします。
 //
 // Handleの各呼び出しは、io.Writer.Writeへの単一のシリアル化された呼び出しの結果を返します。
-func (h *TextHandler) Handle(_ context.Context, r Record) error
+func (h *TextHandler) Handle(ctx context.Context, r Record) error
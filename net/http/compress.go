@@ -0,0 +1,87 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http
+
+import (
+	"github.com/shogo82148/std/io"
+	"github.com/shogo82148/std/sync"
+	"github.com/shogo82148/std/sync/atomic"
+)
+
+// ResponseCompressionは、クライアントのAccept-Encodingヘッダーに基づいて
+// レスポンスボディを透過的に圧縮します。
+//
+// デフォルトでは、"zstd"、"gzip"、"deflate"のコンテンツコーディングが
+// この優先順位でサポートされます。Accept-Encodingのqvalueが優先順位より優先されます。
+// 圧縮されたレスポンスには、Content-EncodingヘッダーとVary: Accept-Encodingヘッダーが付与され、
+// Content-Lengthヘッダーは削除されます。
+//
+// 次のレスポンスは圧縮されません。
+//
+//   - HEADリクエストへのレスポンス、およびボディを持たないステータスコードのレスポンス
+//   - ハンドラーが既にContent-Encodingヘッダーを設定したレスポンス
+//   - Rangeリクエストへの206 Partial Contentレスポンス
+//   - Content-Typeが既に圧縮された形式を示すレスポンス
+//     （画像、音声、動画、フォント、およびzip、gzip、zstdなどのアーカイブ形式）
+//   - ハンドラーが戻るか最初にFlushを呼び出すまでに書き込まれたボディの長さが
+//     最小サイズに満たないレスポンス
+//
+// Content-Typeが設定されていない場合、[DetectContentType] と同じ
+// スニッフィングテーブルを使用してコンテンツタイプが判定されます。
+//
+// ラップされた [ResponseWriter] は、[Flusher] と [Hijacker] を、
+// 元のResponseWriterがそれらをサポートする場合に実装します。
+// Flushは、保留中の圧縮データを書き出してからクライアントにフラッシュします。
+// Hijackは、ボディがまだ書き込まれていない場合にのみ成功します。
+// ラップされたResponseWriterはUnwrapメソッドを持つため、
+// [ResponseController] も引き続き使用できます。
+//
+// ResponseCompressionのゼロ値は有効であり、デフォルトの設定を使用します。
+type ResponseCompression struct {
+	mu       sync.RWMutex
+	encoders map[string]func(io.Writer) io.WriteCloser
+	order    []string
+	skip     map[string]bool
+	minSize  atomic.Int64
+}
+
+// NewResponseCompressionは、新しい [ResponseCompression] の値を返します。
+func NewResponseCompression() *ResponseCompression
+
+// SetEncoderは、コンテンツコーディングcodingのエンコーダーを設定します。
+// newWriterは、各レスポンスについてwへの圧縮データを書き込む [io.WriteCloser] を返します。
+// 返された値がFlush() errorメソッドを持つ場合、ハンドラーのFlushで呼び出されます。
+// 新しいコーディングは、既存のコーディングよりも低い優先順位で追加されます。
+// newWriterがnilの場合、codingのサポートを削除します。
+//
+// SetEncoderは、他のメソッドやリクエスト処理と同時に呼び出すことができ、
+// 以降のリクエストに適用されます。
+func (c *ResponseCompression) SetEncoder(coding string, newWriter func(w io.Writer) io.WriteCloser)
+
+// SetMinSizeは、圧縮を行うボディの最小サイズをnバイトに設定します。
+// ハンドラーが戻るか最初にFlushを呼び出すまでに書き込まれたボディが
+// nバイト未満の場合、レスポンスは圧縮されずに送信されます。
+// それまでの間、ボディは最大nバイトまでバッファリングされます。
+// デフォルトは1024バイトです。
+//
+// SetMinSizeは、他のメソッドやリクエスト処理と同時に呼び出すことができ、
+// 以降のリクエストに適用されます。
+func (c *ResponseCompression) SetMinSize(n int)
+
+// AddUncompressibleTypeは、圧縮しないメディアタイプを追加します。
+// mediaTypeは"application/x-foo"のような完全なタイプ、
+// または"video/*"のようなワイルドカードのサブタイプを持つタイプです。
+//
+// AddUncompressibleTypeは、他のメソッドやリクエスト処理と同時に呼び出すことができ、
+// 以降のリクエストに適用されます。
+func (c *ResponseCompression) AddUncompressibleType(mediaType string)
+
+// Handlerは、Accept-Encodingヘッダーに従ってレスポンスを圧縮しながら
+// ハンドラーhを呼び出すハンドラーを返します。
+func (c *ResponseCompression) Handler(h Handler) Handler
+
+// CompressHandlerは、デフォルトの設定の [ResponseCompression] を使用して
+// hのレスポンスを圧縮するハンドラーを返します。
+func CompressHandler(h Handler) Handler
@@ -208,3 +208,33 @@ func ExampleProtocols_http1or2() {
 	}
 	res.Body.Close()
 }
+
+func ExampleResponseCompression() {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprintln(w, "Hello, compressed world!")
+	})
+
+	// 小さなレスポンスも圧縮し、独自の圧縮済み形式は圧縮しません。
+	c := http.NewResponseCompression()
+	c.SetMinSize(0)
+	c.AddUncompressibleType("application/x-foo")
+
+	log.Fatal(http.ListenAndServe(":8080", c.Handler(mux)))
+}
+
+func ExampleProtocols_http3() {
+	srv := http.Server{
+		Addr: ":8443",
+	}
+
+	// HTTP/1、HTTP/2に加えて、同じポートのUDPでHTTP/3を提供します。
+	// HTTP/1とHTTP/2のレスポンスはAlt-SvcヘッダーでHTTP/3を広告します。
+	srv.Protocols = new(http.Protocols)
+	srv.Protocols.SetHTTP1(true)
+	srv.Protocols.SetHTTP2(true)
+	srv.Protocols.SetHTTP3(true)
+
+	log.Fatal(srv.ListenAndServeTLS("cert.pem", "key.pem"))
+}
@@ -20,6 +20,8 @@ import (
 //   - HTTP2はTLS接続上のHTTP/2プロトコルです。
 //
 //   - UnencryptedHTTP2は非暗号化TCP接続上のHTTP/2プロトコルです。
+//
+//   - HTTP3はQUIC接続上のHTTP/3プロトコルです。
 type Protocols struct {
 	bits uint8
 }
@@ -42,6 +44,12 @@ func (p Protocols) UnencryptedHTTP2() bool
 // SetUnencryptedHTTP2はpに暗号化されていないHTTP/2を追加または削除します。
 func (p *Protocols) SetUnencryptedHTTP2(ok bool)
 
+// HTTP3はpにHTTP/3が含まれているかどうかを報告します。
+func (p Protocols) HTTP3() bool
+
+// SetHTTP3はpにHTTP/3を追加または削除します。
+func (p *Protocols) SetHTTP3(ok bool)
+
 func (p Protocols) String() string
 
 // NoBodyはバイト数ゼロの [io.ReadCloser] です。Readは常にEOFを返し、
@@ -137,3 +145,50 @@ type HTTP2Config struct {
 	// (a-z, 0-9, _).
 	CountError func(errType string)
 }
+
+// HTTP3Configは、[Transport] と [Server] に共通のHTTP/3の設定パラメータを定義します。
+type HTTP3Config struct {
+	// MaxConcurrentStreamsは、ピアが同時に開くことができる
+	// 双方向ストリームの数をオプションで指定します。
+	// ゼロの場合、MaxConcurrentStreamsは少なくとも100になります。
+	MaxConcurrentStreams int
+
+	// MaxFieldSectionSizeは、ピアから受け入れるヘッダーフィールドセクションの
+	// 最大サイズをオプションで指定します。
+	// ゼロの場合、デフォルト値が使用されます。
+	MaxFieldSectionSize int
+
+	// MaxQPACKTableCapacityは、ピアから送信されるヘッダーのデコードに使用される
+	// QPACK動的テーブルの最大容量をオプションで指定します。
+	// ゼロの場合、動的テーブルは使用されません。
+	MaxQPACKTableCapacity int
+
+	// MaxReceiveBufferPerConnectionは、接続で受信するデータの
+	// フロー制御ウィンドウの最大サイズです。
+	// ゼロの場合、デフォルト値が使用されます。
+	MaxReceiveBufferPerConnection int
+
+	// MaxReceiveBufferPerStreamは、ストリーム（リクエスト）で受信するデータの
+	// フロー制御ウィンドウの最大サイズです。
+	// ゼロの場合、デフォルト値が使用されます。
+	MaxReceiveBufferPerStream int
+
+	// MaxIdleTimeoutは、ピアからパケットを受信しない場合に
+	// QUIC接続を閉じるまでの時間です。
+	// ゼロの場合、デフォルトの30秒が使用されます。
+	MaxIdleTimeout time.Duration
+
+	// KeepAlivePeriodは、アイドル状態の接続を維持するためにPINGフレームを送信する間隔です。
+	// ゼロの場合、キープアライブは送信されません。
+	KeepAlivePeriod time.Duration
+
+	// AltSvcMaxAgeは、サーバーがAlt-Svcヘッダーで広告するHTTP/3エンドポイントの
+	// 有効期間です。ゼロの場合、デフォルトの24時間が使用されます。
+	// このフィールドは [Server] でのみ使用されます。
+	AltSvcMaxAge time.Duration
+
+	// CountErrorがnilでない場合、HTTP/3およびQUICのエラーで呼び出されます。
+	// 監視のためのメトリクスをインクリメントすることを目的としています。
+	// errTypeには、小文字、数字、アンダースコア（a-z、0-9、_）のみが含まれます。
+	CountError func(errType string)
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httptest
+
+import (
+	"github.com/shogo82148/std/net/http"
+	"github.com/shogo82148/std/sync"
+	"github.com/shogo82148/std/testing"
+)
+
+// ReplayTransportは、外部のHTTPサーバーとのやり取りをゴールデンファイルに記録し、
+// 後のテスト実行でそれを再生する [http.RoundTripper] です。
+//
+// 記録モードでは、ReplayTransportはリクエストを [ReplayTransport.Base] に送信し、
+// リクエストとレスポンスの組を順に保存します。
+// テストの終了時に、保存された組はtxtar形式のゴールデンファイルに書き込まれます。
+// 各組は、"NNN.request"と"NNN.response"という名前の2つのファイルとして、
+// HTTP/1.1のワイヤーフォーマットで格納されます。
+// そのため、ゴールデンファイルは差分を確認しやすく、手で編集することもできます。
+//
+// 再生モードでは、ReplayTransportはネットワークにアクセスせず、
+// ゴールデンファイルから記録されたレスポンスを返します。
+// リクエストは、メソッド、URL、および [ReplayTransport.MatchHeaders] に
+// 列挙されたヘッダーが一致する、まだ使用されていない最初の記録と照合されます。
+// 一致する記録がない場合、RoundTripはテストを失敗させ、エラーを返します。
+// テストの終了時に使用されなかった記録が残っている場合も、テストは失敗します。
+//
+// ReplayTransportは、複数のゴルーチンから同時に使用しても安全です。
+// ただし、同時に送信されたリクエストの記録の順序は決定的ではありません。
+type ReplayTransport struct {
+	// Baseは、記録モードでリクエストを送信するために使用されるRoundTripperです。
+	// nilの場合、[http.DefaultTransport] が使用されます。
+	Base http.RoundTripper
+
+	// MatchHeadersは、再生モードでメソッドとURLに加えて一致しなければならない
+	// リクエストヘッダーのキーです。
+	//
+	// 置き換えられるヘッダーは記録に元の値が残らないため、照合に使用できません。
+	// MatchHeadersに"Authorization"や"Cookie"、または [ReplayTransport.RedactHeaders] に
+	// 含まれるキーが含まれている場合、最初のRoundTripはテストを失敗させ、エラーを返します。
+	MatchHeaders []string
+
+	// RedactHeadersは、記録時に値が"REDACTED"に置き換えられる
+	// リクエストおよびレスポンスヘッダーのキーです。
+	// "Authorization"、"Cookie"、"Set-Cookie"ヘッダーは常に置き換えられます。
+	RedactHeaders []string
+
+	t      testing.TB
+	file   string
+	record bool
+
+	mu      sync.Mutex
+	loaded  bool
+	entries []*replayEntry
+	used    []bool
+}
+
+// NewReplayTransportは、ゴールデンファイルfileを使用する新しい [ReplayTransport] を返します。
+// recordがtrueの場合、トランスポートは記録モードで動作し、テストの終了時にfileを上書きします。
+// そうでない場合は再生モードで動作し、最初のリクエストの時点でfileを読み込みます。
+//
+// 一般的に、recordはテストパッケージで定義された-updateフラグから設定されます。
+//
+//	var update = flag.Bool("update", false, "update golden files")
+//
+//	func TestAPI(t *testing.T) {
+//		rt := httptest.NewReplayTransport(t, "testdata/api.txtar", *update)
+//		client := &http.Client{Transport: rt}
+//		...
+//	}
+func NewReplayTransport(t testing.TB, file string, record bool) *ReplayTransport
+
+// RoundTripは [http.RoundTripper] を実装します。
+func (rt *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error)
@@ -443,12 +443,20 @@ type Server struct {
 	// HTTP2 configures HTTP/2 connections.
 	HTTP2 *HTTP2Config
 
+	// HTTP3 configures HTTP/3 connections.
+	HTTP3 *HTTP3Config
+
 	// Protocols is the set of protocols accepted by the server.
 	//
 	// If Protocols includes UnencryptedHTTP2, the server will accept
 	// unencrypted HTTP/2 connections. The server can serve both
 	// HTTP/1 and unencrypted HTTP/2 on the same address and port.
 	//
+	// If Protocols includes HTTP3, [Server.ListenAndServeTLS] also
+	// accepts QUIC connections on UDP at the same port number.
+	// HTTP/1 and HTTP/2 responses include an Alt-Svc header
+	// advertising the HTTP/3 endpoint.
+	//
 	// If Protocols is nil, the default is usually HTTP/1 and HTTP/2.
 	// If TLSNextProto is non-nil and does not contain an "h2" entry,
 	// the default is HTTP/1 only.
@@ -623,6 +631,21 @@ func ListenAndServeTLS(addr, certFile, keyFile string, handler Handler) error
 // [Server.Close] の後、返されるエラーは [ErrServerClosed] です。
 func (srv *Server) ListenAndServeTLS(certFile, keyFile string) error
 
+// ServeQUICは、PacketConn pc上でQUIC接続を受け付け、各接続でHTTP/3リクエストを処理し、
+// srv.Handlerを呼び出して応答します。
+//
+// srv.TLSConfigとcertFile、keyFileは [Server.ServeTLS] と同様に扱われます。
+// TLSの設定のNextProtosは"h3"に置き換えられ、TLS 1.3が必須となります。
+//
+// srv.Protocolsに HTTP3 が含まれている必要はありません。
+// ServeQUICで受け付けられた接続についてAlt-Svcヘッダーは送信されません。
+// [Server.Shutdown] はQUIC接続にもGOAWAYフレームを送信し、
+// 処理中のリクエストが完了するのを待ちます。
+//
+// ServeQUICは常に非nilのエラーを返します。[Server.Shutdown] または
+// [Server.Close] の後、返されるエラーは [ErrServerClosed] です。
+func (srv *Server) ServeQUIC(pc net.PacketConn, certFile, keyFile string) error
+
 // TimeoutHandlerは、指定された時間制限でhを実行する [Handler] を返します。
 //
 // 新しいHandlerは、各リクエストを処理するためにh.ServeHTTPを呼び出しますが、
@@ -0,0 +1,47 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tracecontext_test
+
+import (
+	"github.com/shogo82148/std/log/slog"
+	"github.com/shogo82148/std/net/http"
+	"github.com/shogo82148/std/net/http/tracecontext"
+	"github.com/shogo82148/std/os"
+)
+
+func Example() {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		AddTraceContext: true,
+	}))
+
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		// 受信したリクエストのtraceparentをコンテキストに格納します。
+		ctx := r.Context()
+		if tp, ok := tracecontext.Extract(r.Header); ok {
+			ctx = tracecontext.WithTraceParent(ctx, tp.Child())
+		} else {
+			ctx = tracecontext.WithTraceParent(ctx, tracecontext.New(tracecontext.FlagSampled))
+		}
+
+		// レコードにはtrace_idとspan_idが追加されます。
+		logger.InfoContext(ctx, "handling request", "path", r.URL.Path)
+
+		// 下流のサービスへのリクエストにtraceparentを伝搬します。
+		req, err := http.NewRequestWithContext(ctx, "GET", "http://backend.example.com/", nil)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		tp, _ := tracecontext.ContextTraceParent(ctx)
+		tracecontext.Inject(req.Header, tp.Child())
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			logger.ErrorContext(ctx, "backend request failed", "err", err)
+			http.Error(w, "bad gateway", http.StatusBadGateway)
+			return
+		}
+		resp.Body.Close()
+	})
+}
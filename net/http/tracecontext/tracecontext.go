@@ -0,0 +1,98 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// tracecontextパッケージは、W3C Trace Context仕様のtraceparentヘッダーを実装します。
+//
+// [TraceParent] は、分散トレースのトレースIDと親スパンID、およびトレースフラグを保持します。
+// 値はHTTPヘッダーから読み取り、コンテキストに格納し、
+// 送信するリクエストのヘッダーに書き込むことができます。
+// [log/slog] の組み込みハンドラは、[log/slog.HandlerOptions] のAddTraceContextが
+// 設定されている場合、コンテキストに格納された識別子をレコードに追加します。
+//
+// このパッケージはtracestateヘッダーを解釈しません。
+//
+// 仕様は https://www.w3.org/TR/trace-context/ にあります。
+package tracecontext
+
+import (
+	"github.com/shogo82148/std/context"
+	"github.com/shogo82148/std/errors"
+)
+
+// HeaderKeyは、traceparentヘッダーの正規化されたキーです。
+const HeaderKey = "Traceparent"
+
+// ErrInvalidは、traceparentヘッダーの値が不正な場合に [Parse] によって返されます。
+var ErrInvalid = errors.New("tracecontext: invalid traceparent")
+
+// TraceIDは、16バイトのトレースIDです。
+type TraceID [16]byte
+
+// IsValidは、tがすべてゼロでないかどうかを報告します。
+func (t TraceID) IsValid() bool
+
+// Stringは、tを32文字の小文字の16進数として返します。
+func (t TraceID) String() string
+
+// SpanIDは、8バイトのスパンIDです。
+type SpanID [8]byte
+
+// IsValidは、sがすべてゼロでないかどうかを報告します。
+func (s SpanID) IsValid() bool
+
+// Stringは、sを16文字の小文字の16進数として返します。
+func (s SpanID) String() string
+
+// Flagsは、トレースフラグです。
+type Flags byte
+
+// FlagSampledは、呼び出し元がトレースを記録した可能性があることを示します。
+const FlagSampled Flags = 0x01
+
+// Sampledは、fに [FlagSampled] が設定されているかどうかを報告します。
+func (f Flags) Sampled() bool
+
+// TraceParentは、traceparentヘッダーの値を表します。
+type TraceParent struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Flags   Flags
+}
+
+// Newは、ランダムなトレースIDとスパンIDを持つ新しい [TraceParent] を返します。
+// 呼び出し元がトレースの起点である場合に使用します。
+func New(flags Flags) TraceParent
+
+// Parseは、traceparentヘッダーの値sを解析します。
+// バージョン00の形式と、仕様に従って将来のバージョンの先頭部分を受け入れます。
+// sが不正な場合、またはトレースIDやスパンIDがすべてゼロの場合、Parseは [ErrInvalid] を返します。
+func Parse(s string) (TraceParent, error)
+
+// IsValidは、トレースIDとスパンIDの両方が有効かどうかを報告します。
+func (tp TraceParent) IsValid() bool
+
+// Stringは、tpをバージョン00のtraceparentヘッダーの値としてフォーマットします。
+func (tp TraceParent) String() string
+
+// Childは、同じトレースIDとフラグを持ち、ランダムな新しいスパンIDを持つ [TraceParent] を返します。
+// 送信するリクエストのために子スパンを開始するときに使用します。
+func (tp TraceParent) Child() TraceParent
+
+// WithTraceParentは、tpを保持する親コンテキストのコピーを返します。
+// 格納されたトレースコンテキストは、[log/slog.HandlerOptions.AddTraceContext] が
+// 設定された組み込みハンドラからも参照されます。
+func WithTraceParent(ctx context.Context, tp TraceParent) context.Context
+
+// ContextTraceParentは、ctxに格納された [TraceParent] を返します。
+// 格納されていない場合は、ゼロ値とfalseを返します。
+func ContextTraceParent(ctx context.Context) (TraceParent, bool)
+
+// Extractは、HTTPヘッダーhからtraceparentヘッダーを読み取ります。
+// hには [net/http.Header] または [net/textproto.MIMEHeader] を渡すことができます。
+// ヘッダーがない場合、または値が不正な場合は、ゼロ値とfalseを返します。
+func Extract(h map[string][]string) (TraceParent, bool)
+
+// Injectは、tpをtraceparentヘッダーとしてhに設定します。
+// tpが有効でない場合、hからtraceparentヘッダーを削除します。
+func Inject(h map[string][]string, tp TraceParent)
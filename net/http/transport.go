@@ -248,11 +248,21 @@ type Transport struct {
 	// HTTP2 configures HTTP/2 connections.
 	HTTP2 *HTTP2Config
 
+	// HTTP3 configures HTTP/3 connections.
+	HTTP3 *HTTP3Config
+
 	// Protocols is the set of protocols supported by the transport.
 	//
 	// If Protocols includes UnencryptedHTTP2 and does not include HTTP1,
 	// the transport will use unencrypted HTTP/2 for requests for http:// URLs.
 	//
+	// If Protocols includes HTTP3, the transport will use HTTP/3 for
+	// requests for https:// URLs to origins that have advertised an
+	// HTTP/3 endpoint with an Alt-Svc header. If Protocols includes only
+	// HTTP3, the transport always uses HTTP/3 for https:// URLs.
+	// If a QUIC connection cannot be established, the transport falls
+	// back to the other enabled protocols.
+	//
 	// If Protocols is nil, the default is usually HTTP/1 only.
 	// If ForceAttemptHTTP2 is true, or if TLSNextProto contains an "h2" entry,
 	// the default is HTTP/1 and HTTP/2.
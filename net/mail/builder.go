@@ -0,0 +1,91 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mail
+
+import (
+	"github.com/shogo82148/std/io"
+	"github.com/shogo82148/std/time"
+)
+
+// Builderは、RFC 5322形式のメールメッセージを作成します。
+//
+// [Builder.WriteTo] は、ヘッダーとMIME構造を持つ完全なメッセージを書き込みます。
+// 書き込まれたメッセージは [ReadMessage] で読み戻すことができます。
+//
+// ヘッダーの値は、行の長さが78文字を超えないように折り返されます。
+// ASCII以外の文字を含む値は、[mime.QEncoding] を使用したencoded-wordとして
+// エンコードされます。
+//
+// 本文の構造は、設定されたフィールドによって決まります。
+// TextとHTMLの両方が設定されている場合、それらはmultipart/alternativeで組み合わされます。
+// ContentIDを持つ添付ファイルは、HTMLとともにmultipart/relatedに含まれ、
+// その他の添付ファイルがある場合は、全体がmultipart/mixedで囲まれます。
+// テキストの本文はquoted-printableで、添付ファイルはbase64でエンコードされます。
+type Builder struct {
+	From    *Address
+	Sender  *Address
+	ReplyTo []*Address
+	To      []*Address
+	Cc      []*Address
+
+	// Bccの受信者はメッセージのヘッダーには書き込まれず、
+	// [Builder.Recipients] でのみ返されます。
+	Bcc []*Address
+
+	Subject string
+
+	// Dateは、Dateヘッダーの値です。
+	// ゼロ値の場合、[Builder.WriteTo] は呼び出した時刻をDateに設定して使用します。
+	Date time.Time
+
+	// MessageIDは、山括弧を含まないMessage-IDヘッダーの値です。
+	// 空の場合、[Builder.WriteTo] はFromアドレスのドメインを使用して一意な値を生成し、
+	// MessageIDに設定します。
+	MessageID string
+
+	// Headerは、追加のヘッダーを保持します。
+	// 上記のフィールドに対応するヘッダー、MIME-Version、およびContent-で始まるヘッダーは無視されます。
+	Header Header
+
+	// TextとHTMLは、text/plainとtext/htmlの本文です。
+	// どちらも空の場合、空のtext/plainの本文が書き込まれます。
+	Text string
+	HTML string
+
+	// Attachmentsは、メッセージに添付されるファイルです。
+	Attachments []*Attachment
+}
+
+// Attachmentは、メッセージに添付されるファイルを表します。
+type Attachment struct {
+	// Filenameは、Content-Dispositionヘッダーのファイル名です。
+	Filename string
+
+	// ContentTypeは、添付ファイルのメディアタイプです。
+	// 空の場合、Filenameの拡張子から [mime.TypeByExtension] で決定され、
+	// 決定できない場合は"application/octet-stream"が使用されます。
+	ContentType string
+
+	// ContentIDが空でない場合、添付ファイルはインラインとして扱われ、
+	// HTMLの本文から"cid:"URLで参照できます。
+	ContentID string
+
+	// Dataは、添付ファイルの内容です。
+	Data []byte
+}
+
+// WriteToは、メッセージをwに書き込みます。
+// 行はCRLFで終端されます。
+//
+// DateまたはMessageIDが設定されていない場合、WriteToは生成した値をbに設定します。
+// そのため、書き込まれたメッセージのMessage-IDは、WriteToから戻った後にb.MessageIDで参照できます。
+// 同じBuilderで再びWriteToを呼び出すと、同じDateとMessage-IDを持つメッセージが書き込まれます。
+//
+// Fromが設定されていない場合、WriteToはエラーを返します。
+func (b *Builder) WriteTo(w io.Writer) (n int64, err error)
+
+// Recipientsは、To、Cc、Bccのすべての受信者のアドレスを、
+// [net/smtp.SendMail] に渡すのに適した形式で返します。
+func (b *Builder) Recipients() []string
@@ -8,6 +8,7 @@ import (
 	"github.com/shogo82148/std/fmt"
 	"github.com/shogo82148/std/io"
 	"github.com/shogo82148/std/log"
+	"github.com/shogo82148/std/mime"
 	"github.com/shogo82148/std/net/mail"
 	"github.com/shogo82148/std/strings"
 	"github.com/shogo82148/std/time"
@@ -90,3 +91,40 @@ func ExampleParseDate() {
 	// Output:
 	// 2024-10-09T09:55:06-07:00
 }
+
+func ExampleBuilder() {
+	b := &mail.Builder{
+		From:    &mail.Address{Name: "Gopher", Address: "gopher@example.com"},
+		To:      []*mail.Address{{Name: "Alice", Address: "alice@example.com"}},
+		Subject: "こんにちは",
+		Date:    time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC),
+		Text:    "Hello, Alice!\n",
+		HTML:    "<p>Hello, <b>Alice</b>!</p>\n",
+		Attachments: []*mail.Attachment{
+			{Filename: "notes.txt", Data: []byte("some notes\n")},
+		},
+	}
+
+	var sb strings.Builder
+	if _, err := b.WriteTo(&sb); err != nil {
+		log.Fatal(err)
+	}
+
+	// 書き込まれたメッセージはReadMessageで読み戻すことができます。
+	m, err := mail.ReadMessage(strings.NewReader(sb.String()))
+	if err != nil {
+		log.Fatal(err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("To:", m.Header.Get("To"))
+	fmt.Println("Subject:", subject)
+	fmt.Println("Recipients:", b.Recipients())
+
+	// Output:
+	// To: "Alice" <alice@example.com>
+	// Subject: こんにちは
+	// Recipients: [alice@example.com]
+}
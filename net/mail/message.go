@@ -3,7 +3,7 @@
 // license that can be found in the LICENSE file.
 
 /*
-mailパッケージは、メールメッセージの解析を実装しています。
+mailパッケージは、メールメッセージの解析と作成を実装しています。
 
 このパッケージは、ほとんどの部分でRFC 5322によって指定され、
 RFC 6532によって拡張された構文に従います。
@@ -5,6 +5,7 @@
 package rpc
 
 import (
+	"github.com/shogo82148/std/context"
 	"github.com/shogo82148/std/errors"
 	"github.com/shogo82148/std/io"
 	"github.com/shogo82148/std/sync"
@@ -24,6 +25,8 @@ type Call struct {
 	Reply         any
 	Error         error
 	Done          chan *Call
+	ctx           context.Context
+	stream        *ClientStream
 }
 
 // ClientはRPCクライアントを表します。
@@ -86,3 +89,36 @@ func (client *Client) Go(serviceMethod string, args any, reply any, done chan *C
 
 // Callは指定された関数を呼び出し、その完了を待ち、エラー状態を返します。
 func (client *Client) Call(serviceMethod string, args any, reply any) error
+
+// GoContextは [Client.Go] と同様ですが、コンテキストを受け取ります。
+// ctxに期限がある場合、その期限はリクエストヘッダーでサーバーに送信されます。
+// 呼び出しが完了する前にctxがキャンセルされた場合、クライアントはサーバーに
+// キャンセルを通知し、Call.Errorにctx.Err()を設定して呼び出しを完了します。
+func (client *Client) GoContext(ctx context.Context, serviceMethod string, args any, reply any, done chan *Call) *Call
+
+// CallContextは指定された関数を呼び出し、その完了またはctxのキャンセルを待ち、エラー状態を返します。
+// 呼び出しがキャンセルされた場合、CallContextはctx.Err()を返します。
+func (client *Client) CallContext(ctx context.Context, serviceMethod string, args any, reply any) error
+
+// ClientStreamは、サーバーストリーミングメソッドの呼び出しを表します。
+type ClientStream struct {
+	client *Client
+	call   *Call
+	values chan []byte
+	err    error
+}
+
+// Streamは、サーバーストリーミングメソッドserviceMethodをargsで呼び出し、
+// その結果を受け取るための [ClientStream] を返します。
+// ctxがキャンセルされると、クライアントはサーバーにキャンセルを通知し、
+// ストリームはctx.Err()で終了します。
+func (client *Client) Stream(ctx context.Context, serviceMethod string, args any) (*ClientStream, error)
+
+// Recvは、ストリームの次の値をreplyに読み込みます。
+// サーバーメソッドがnilを返してストリームが終了した場合、Recvは [io.EOF] を返します。
+// サーバーメソッドがエラーを返した場合、Recvはそのエラーを [ServerError] として返します。
+func (s *ClientStream) Recv(reply any) error
+
+// Closeは、ストリームの残りの値を破棄し、まだ終了していない場合はサーバーに
+// キャンセルを通知します。
+func (s *ClientStream) Close() error
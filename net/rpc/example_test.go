@@ -0,0 +1,78 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc_test
+
+import (
+	"github.com/shogo82148/std/context"
+	"github.com/shogo82148/std/errors"
+	"github.com/shogo82148/std/fmt"
+	"github.com/shogo82148/std/io"
+	"github.com/shogo82148/std/log"
+	"github.com/shogo82148/std/net/rpc"
+	"github.com/shogo82148/std/time"
+)
+
+func ExampleClient_CallContext() {
+	client, err := rpc.Dial("tcp", "127.0.0.1:1234")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	// 期限はリクエストヘッダーでサーバーに伝えられ、
+	// サーバー側のメソッドに渡されるコンテキストもその時刻にキャンセルされます。
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	var reply int
+	err = client.CallContext(ctx, "Arith.Multiply", &Args{7, 8}, &reply)
+	if errors.Is(err, context.DeadlineExceeded) {
+		log.Fatal("timed out")
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(reply)
+}
+
+func ExampleClient_Stream() {
+	client, err := rpc.Dial("tcp", "127.0.0.1:1234")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	// サーバーは次のようなメソッドを登録しているものとします。
+	//
+	//	func (t *Counter) Count(ctx context.Context, n int, stream *rpc.ServerStream) error {
+	//		for i := range n {
+	//			if err := stream.Send(i); err != nil {
+	//				return err
+	//			}
+	//		}
+	//		return nil
+	//	}
+	stream, err := client.Stream(context.Background(), "Counter.Count", 3)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer stream.Close()
+
+	for {
+		var v int
+		err := stream.Recv(&v)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(v)
+	}
+}
+
+type Args struct {
+	A, B int
+}
@@ -4,6 +4,21 @@
 
 // jsonrpcパッケージはRPCパッケージのためのJSON-RPC 1.0のClientCodecとServerCodecを実装します。
 // JSON-RPC 2.0のサポートについては、 https://godoc.org/?q=json-rpc+2.0 を参照してください。
+//
+// このパッケージは、コンテキストの期限、キャンセル、およびサーバーストリーミングを
+// 伝えるために、次のパッケージ独自の拡張メンバーを使用します。
+// これらはJSON-RPC 1.0の仕様の一部ではなく、標準のJSON-RPC 1.0の実装は理解しません。
+//
+//   - リクエストの"deadline"は、[rpc.Request.Deadline] をRFC 3339形式の文字列で保持します。
+//   - リクエストの"cancel"がtrueの場合、同じ"id"を持つ実行中の呼び出しのキャンセルを要求します。
+//     このとき"method"は空で、"params"は空の配列です。
+//   - レスポンスの"more"がtrueの場合、同じ"id"を持つレスポンスがさらに続きます
+//     （[rpc.Response.More] を参照）。
+//
+// 拡張メンバーは、呼び出しのコンテキストに期限がある場合、呼び出しがキャンセルされた場合、
+// およびストリームを使用する場合にのみ送信されます。それ以外の場合、クライアントは
+// 拡張メンバーを含まない通常のJSON-RPC 1.0のリクエストを送信するため、
+// 拡張をサポートしない相手とも通信できます。
 package jsonrpc
 
 import (
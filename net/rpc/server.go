@@ -5,7 +5,7 @@
 /*
 Package rpcは、ネットワークやその他のI/O接続を介してオブジェクトのエクスポートされたメソッドへアクセスする機能を提供します。
 
-net/rpcパッケージは凍結されており、新しい機能は受け付けていません。
+net/rpcパッケージは凍結されており、このドキュメントで説明するコンテキストとストリーミングのサポートを除き、新しい機能は受け付けていません。
 
 サーバーはオブジェクトを登録し、その型名をサービス名として公開します。
 登録後、オブジェクトのエクスポートされたメソッドはリモートからアクセス可能になります。
@@ -32,12 +32,31 @@ net/rpcパッケージは凍結されており、新しい機能は受け付け
 メソッドの戻り値がnilでない場合、それはクライアントが [errors.New] によって作成されたかのようにクライアントが確認する文字列として送り返されます。
 エラーが返された場合、応答パラメータはクライアントに送り返されません。
 
+メソッドは、これら2つの引数の前に [context.Context] 型の引数を受け取ることもできます。
+
+	func (t *T) MethodName(ctx context.Context, argType T1, replyType *T2) error
+
+このコンテキストは、クライアントが呼び出しをキャンセルしたとき、
+クライアントが指定した期限（[Request.Deadline]）を過ぎたとき、
+または接続が閉じられたときにキャンセルされます。
+
+サーバーストリーミングメソッドは、応答パラメータの代わりに [*ServerStream] を受け取ります。
+
+	func (t *T) MethodName(ctx context.Context, argType T1, stream *rpc.ServerStream) error
+
+メソッドは [ServerStream.Send] を任意の回数呼び出して値をクライアントに送信し、
+最後にエラーを返してストリームを終了します。クライアントは [Client.Stream] で
+ストリームを開き、[ClientStream.Recv] で値を受け取ります。
+
 サーバーは、[ServeConn] を呼び出すことによって単一の接続上のリクエストを処理することができます。また、通常はネットワークリスナーを作成し、[Accept] を呼び出すか、HTTPリスナーの場合は [HandleHTTP] と [http.Serve] を呼び出します。
 
 サービスを使用するためには、クライアントは接続を確立し、その後、接続上で [NewClient] を呼び出します。[Dial]（[DialHTTP]）という便利な関数は、生のネットワーク接続（HTTP接続）に対して両方の手順を実行します。結果として得られる [Client] オブジェクトには、サービスとメソッドを指定するための2つのメソッド、[Call] とGoがあり、引数を含むポインタと結果パラメータを受け取るポインタを指定します。
 
 Callメソッドは、リモート呼び出しが完了するまで待機し、
 Goメソッドは非同期に呼び出しを開始し、Call構造体のDoneチャネルを使用して完了をシグナルします。
+[Client.CallContext] と [Client.GoContext] はコンテキストを受け取り、
+コンテキストがキャンセルされると、呼び出しを中断してサーバーにキャンセルを通知します。
+コンテキストに期限がある場合、その期限はリクエストヘッダーでサーバーに伝えられます。
 
 明示的なコーデックが設定されていない場合、データの転送には [encoding/gob] パッケージが使用されます。
 
@@ -113,10 +132,12 @@ Goメソッドは非同期に呼び出しを開始し、Call構造体のDoneチ
 package rpc
 
 import (
+	"github.com/shogo82148/std/context"
 	"github.com/shogo82148/std/io"
 	"github.com/shogo82148/std/net"
 	"github.com/shogo82148/std/net/http"
 	"github.com/shogo82148/std/sync"
+	"github.com/shogo82148/std/time"
 )
 
 const (
@@ -126,17 +147,27 @@ const (
 )
 
 // RequestはRPC呼び出しの前に書かれるヘッダーです。内部で使用されますが、ネットワークトラフィックを分析する際などデバッグの支援のためにここで記述されています。
+//
+// Deadlineがゼロでない場合、サーバーはその時刻にメソッドに渡すコンテキストをキャンセルします。
+// Cancelがtrueのリクエストは新しい呼び出しではなく、同じSeqを持つ
+// 実行中の呼び出しのキャンセルを要求します。このリクエストの本文は空です。
 type Request struct {
 	ServiceMethod string
 	Seq           uint64
+	Deadline      time.Time
+	Cancel        bool
 	next          *Request
 }
 
 // Responseは、すべてのRPCの戻り値の前に書かれるヘッダです。内部で使用されますが、ネットワークトラフィックを分析する際など、デバッグの支援としてここで文書化されています。
+//
+// Moreがtrueの場合、レスポンスはサーバーストリーミングメソッドが送信した値の1つであり、
+// 同じSeqを持つレスポンスがさらに続きます。ストリームの最後のレスポンスではMoreはfalseです。
 type Response struct {
 	ServiceMethod string
 	Seq           uint64
 	Error         string
+	More          bool
 	next          *Response
 }
 
@@ -161,6 +192,9 @@ var DefaultServer = NewServer()
 //   - 2番目の引数がポインタであること
 //   - エラー型の1つの戻り値
 //
+// 2つの引数の前に [context.Context] 型の引数を持つメソッドや、
+// 2番目の引数が [*ServerStream] であるサーバーストリーミングメソッドも公開されます。
+//
 // レシーバーがエクスポートされた型でないか、適切なメソッドがない場合は、エラーを返します。また、エラーをパッケージlogを使用してログに記録します。
 // クライアントは "Type.Method" の形式の文字列を使用して各メソッドにアクセスします。ここで、Typeはレシーバーの具体的な型です。
 func (server *Server) Register(rcvr any) error
@@ -194,6 +228,25 @@ func Register(rcvr any) error
 // RegisterNameは、レシーバの具体的な型ではなく、与えられた名前を型として使用します。[Register] と同様の動作です。
 func RegisterName(name string, rcvr any) error
 
+// ServerStreamは、サーバーストリーミングメソッドがクライアントに値を送信するために使用します。
+// ServerStreamはメソッドが戻るまでの間だけ有効です。
+type ServerStream struct {
+	server  *Server
+	codec   ServerCodec
+	sending *sync.Mutex
+	req     *Request
+	ctx     context.Context
+}
+
+// Contextは、ストリームに関連付けられたコンテキストを返します。
+// これはメソッドの最初の引数として渡されるコンテキストと同じです。
+func (s *ServerStream) Context() context.Context
+
+// Sendは、replyをストリームの次の値としてクライアントに送信します。
+// replyの型は呼び出しごとに同じでなければなりません。
+// ストリームのコンテキストがキャンセルされた後は、Sendはそのエラーを返します。
+func (s *ServerStream) Send(reply any) error
+
 // ServerCodecはRPCセッションのサーバー側でのRPCリクエストの読み取りとRPCレスポンスの書き込みを実装します。
 // サーバーは [ServerCodec.ReadRequestHeader] と [ServerCodec.ReadRequestBody] をペアで呼び出して接続からリクエストを読み取り、[ServerCodec.WriteResponse] を呼び出してレスポンスを書き込みます。
 // サーバーは接続が終了したら [ServerCodec.Close] を呼び出します。ReadRequestBodyはnilの引数で呼び出されることがあり、リクエストの本文を読み取って破棄するためのものです。
@@ -7,6 +7,7 @@ package smtp_test
 import (
 	"github.com/shogo82148/std/fmt"
 	"github.com/shogo82148/std/log"
+	"github.com/shogo82148/std/net/mail"
 	"github.com/shogo82148/std/net/smtp"
 )
 
@@ -73,3 +74,16 @@ func ExampleSendMail() {
 		log.Fatal(err)
 	}
 }
+
+func ExampleServer() {
+	srv := &smtp.Server{
+		Addr:     "127.0.0.1:2525",
+		Hostname: "mx.example.com",
+		Handler: smtp.HandlerFunc(func(env *smtp.Envelope, msg *mail.Message) error {
+			// 受信したメッセージを記録します。
+			log.Printf("from %s to %v: %s", env.From, env.To, msg.Header.Get("Subject"))
+			return nil
+		}),
+	}
+	log.Fatal(srv.ListenAndServe())
+}
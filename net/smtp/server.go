@@ -0,0 +1,156 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package smtp
+
+import (
+	"github.com/shogo82148/std/context"
+	"github.com/shogo82148/std/crypto/tls"
+	"github.com/shogo82148/std/errors"
+	"github.com/shogo82148/std/log"
+	"github.com/shogo82148/std/net"
+	"github.com/shogo82148/std/net/mail"
+	"github.com/shogo82148/std/sync"
+	"github.com/shogo82148/std/sync/atomic"
+	"github.com/shogo82148/std/time"
+)
+
+// Envelopeは、SMTPセッションで受信したメッセージのエンベロープ情報を保持します。
+type Envelope struct {
+	// Fromは、MAIL FROMコマンドで指定された送信者のアドレスです。
+	// 配送不能通知の場合は空文字列になります。
+	From string
+
+	// Toは、RCPT TOコマンドで受け付けられた受信者のアドレスです。
+	To []string
+
+	// BodyTypeは、MAIL FROMコマンドのBODYパラメータの値です。
+	// "7BIT"または"8BITMIME"のいずれかです。
+	BodyType string
+
+	// Helloは、クライアントがHELOまたはEHLOコマンドで名乗ったホスト名です。
+	Hello string
+
+	// RemoteAddrは、クライアントのネットワークアドレスです。
+	RemoteAddr net.Addr
+
+	// TLSは、STARTTLSが完了している場合、接続のTLSの状態を保持します。
+	// そうでない場合はnilです。
+	TLS *tls.ConnectionState
+
+	// AuthUserは、AUTHコマンドで認証されたユーザー名です。
+	// 認証されていない場合は空文字列です。
+	AuthUser string
+}
+
+// Handlerは、[Server] が受信したメッセージに応答します。
+//
+// ServeSMTPは、DATAコマンドでメッセージの受信が完了した後に呼び出されます。
+// msgのBodyは、ServeSMTPが戻るまでの間だけ読み取ることができます。
+// ServeSMTPがnilを返した場合、サーバーはメッセージを受け付けたことをクライアントに応答します。
+// [*textproto.Error] を返した場合、サーバーはそのコードとメッセージで応答します。
+// その他のエラーの場合、サーバーは451の一時的なエラーで応答します。
+type Handler interface {
+	ServeSMTP(env *Envelope, msg *mail.Message) error
+}
+
+// HandlerFunc型は、通常の関数をSMTPハンドラとして使用するためのアダプタです。
+type HandlerFunc func(env *Envelope, msg *mail.Message) error
+
+// ServeSMTPはf(env, msg)を呼び出します。
+func (f HandlerFunc) ServeSMTP(env *Envelope, msg *mail.Message) error
+
+// ErrServerClosedは、[Server.Shutdown] または [Server.Close] の呼び出し後に、
+// [Server.Serve] と [Server.ListenAndServe] によって返されます。
+var ErrServerClosed = errors.New("smtp: Server closed")
+
+// Serverは、SMTPサーバーを実行するためのパラメータを定義します。
+// Serverのゼロ値は、":smtp"で待ち受け、すべてのメッセージを拒否する有効な設定です。
+//
+// サーバーは、EHLOコマンドに応じて8BITMIME、SIZE、PIPELININGの拡張を広告します。
+// TLSConfigが設定されている場合はSTARTTLSを、Authenticateが設定されている場合は
+// AUTH PLAINを広告します。AUTHは、TLS接続上でのみ受け付けられます。
+type Server struct {
+	// Addrは、サーバーが待ち受けるTCPアドレスを"host:port"の形式で指定します。
+	// 空の場合、":smtp"（ポート25）が使用されます。
+	Addr string
+
+	// Hostnameは、挨拶とEHLOの応答でサーバーが名乗るホスト名です。
+	// 空の場合、[os.Hostname] の結果が使用されます。
+	Hostname string
+
+	// Handlerは、受信した各メッセージを処理します。
+	// nilの場合、すべてのメッセージは554のエラーで拒否されます。
+	Handler Handler
+
+	// TLSConfigがnilでない場合、サーバーはSTARTTLS拡張を広告し、
+	// この設定を使用してTLSハンドシェイクを行います。
+	TLSConfig *tls.Config
+
+	// RequireTLSがtrueの場合、サーバーはSTARTTLSが完了するまで
+	// MAILコマンドを拒否します。
+	RequireTLS bool
+
+	// Authenticateがnilでない場合、サーバーはAUTH PLAIN拡張を広告し、
+	// クライアントが送信した資格情報を検証するために呼び出します。
+	// Authenticateがエラーを返した場合、認証は535の応答で失敗します。
+	Authenticate func(identity, username, password string) error
+
+	// RequireAuthがtrueの場合、サーバーは認証が完了するまでMAILコマンドを拒否します。
+	RequireAuth bool
+
+	// MaxMessageBytesは、受け付けるメッセージの最大サイズをバイト単位で指定します。
+	// この値はSIZE拡張で広告されます。
+	// ゼロの場合、10 MiBが使用されます。
+	MaxMessageBytes int64
+
+	// MaxRecipientsは、1つのメッセージで受け付ける受信者の最大数です。
+	// ゼロの場合、100が使用されます。
+	MaxRecipients int
+
+	// ReadTimeoutとWriteTimeoutは、各コマンドの読み取りと
+	// 各応答の書き込みの最大時間です。ゼロの場合、タイムアウトはありません。
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+
+	// ErrorLogは、接続の受け入れ時のエラーやプロトコルのエラーに対するオプションのロガーを指定します。
+	// nilの場合、ログはlogパッケージの標準ロガーを使用して行われます。
+	ErrorLog *log.Logger
+
+	inShutdown atomic.Bool
+
+	mu         sync.Mutex
+	listeners  map[*net.Listener]struct{}
+	activeConn map[*serverConn]struct{}
+	onShutdown []func()
+}
+
+// ListenAndServeは、TCPネットワークアドレスsrv.Addrで待ち受け、
+// [Server.Serve] を呼び出して着信接続を処理します。
+//
+// ListenAndServeは常に非nilのエラーを返します。[Server.Shutdown] または
+// [Server.Close] の後、返されるエラーは [ErrServerClosed] です。
+func (srv *Server) ListenAndServe() error
+
+// Serveは、リスナーl上で着信接続を受け入れ、それぞれに対して新しいサービスゴルーチンを作成します。
+// サービスゴルーチンはSMTPコマンドを読み取り、受信したメッセージをsrv.Handlerに渡します。
+//
+// Serveは常に非nilのエラーを返し、lを閉じます。
+// [Server.Shutdown] または [Server.Close] の後、返されるエラーは [ErrServerClosed] です。
+func (srv *Server) Serve(l net.Listener) error
+
+// ServeConnは、単一の接続cでSMTPセッションを処理し、セッションが終了すると戻ります。
+// これは、[net.Pipe] を使用したテストに便利です。
+func (srv *Server) ServeConn(c net.Conn)
+
+// Shutdownは、アクティブな接続を中断することなくサーバーを正常にシャットダウンします。
+// Shutdownは、まずすべてのリスナーを閉じ、次にメッセージの受信中でない接続に
+// 421の応答を送信して閉じ、残りの接続のセッションが終了するのを待ちます。
+// 提供されたコンテキストがシャットダウンが完了する前に期限切れになった場合、
+// Shutdownはコンテキストのエラーを返します。
+func (srv *Server) Shutdown(ctx context.Context) error
+
+// Closeは、すべてのアクティブなリスナーと接続を直ちに閉じます。
+// 正常なシャットダウンには、[Server.Shutdown] を使用してください。
+func (srv *Server) Close() error
@@ -5,13 +5,18 @@
 // smtp パッケージは RFC 5321 で定義されている Simple Mail Transfer Protocol を実装しています。
 // さらに、以下の拡張も実装しています:
 //
-//	8BITMIME  RFC 1652
-//	AUTH      RFC 2554
-//	STARTTLS  RFC 3207
+//	8BITMIME    RFC 1652
+//	AUTH        RFC 2554
+//	STARTTLS    RFC 3207
+//	SIZE        RFC 1870 (サーバーのみ)
+//	PIPELINING  RFC 2920 (サーバーのみ)
 //
 // クライアント側で追加の拡張も扱うことができます。
 //
-// smtp パッケージは凍結されており、新しい機能の追加は受け付けていません。
+// [Server] は、テストや簡単なリレーのためのSMTPサーバーを実装します。
+// 受信した各メッセージは [Handler] に渡されます。
+//
+// smtp パッケージのクライアントは凍結されており、新しい機能の追加は受け付けていません。
 // いくつかの外部パッケージがより多機能を提供しています。以下を参照してください:
 //
 //	https://godoc.org/?q=smtp
@@ -0,0 +1,226 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fstest
+
+import (
+	"github.com/shogo82148/std/io/fs"
+	"github.com/shogo82148/std/sync"
+	"github.com/shogo82148/std/time"
+)
+
+// MemFSは、書き込み可能なインメモリファイルシステムです。
+// [MapFS] と異なり、ファイルの作成、変更、削除、名前の変更を
+// メソッドを通じて行うことができます。
+//
+// MemFSは [os.Root] の代わりとして使用できるものではありません。
+// Mkdir、MkdirAll、Remove、RemoveAll、Rename、Link、Symlink、Stat、Lstat、
+// Chmod、Chtimes、ReadFile、WriteFileは、[os.Root] の同名のメソッドと同じシグネチャを持ち、
+// POSIXに従って同じように振る舞います。
+// ファイルを書き込むコードをこれらのメソッドからなるインターフェースに対して書くことで、
+// 一時ディレクトリを使わずにMemFSでテストできます。
+// Open、Create、OpenFile、OpenRootは [*os.File] や [*os.Root] ではなく
+// [fs.File]、[*MemFile]、[*MemFS] を返すため、シグネチャが異なります。
+// シンボリックリンクのリンク先は、[os.Root.Readlink] ではなく
+// [fs.ReadLinkFS] に従う [MemFS.ReadLink] で読み取ります。
+//
+// パス名は [fs.ValidPath] に従うスラッシュ区切りの名前で、ルートからの相対パスです。
+// シンボリックリンクは辿られますが、ルートの外を指すリンクは辿られません。
+//
+// エラーは [*fs.PathError] または [*os.LinkError] に相当する [*LinkError] で報告され、
+// [errors.Is] を使って [fs.ErrNotExist]、[fs.ErrExist]、[fs.ErrPermission]、
+// [fs.ErrInvalid] と比較できます。
+//
+// MemFSは複数のゴルーチンから同時に使用しても安全です。
+// ゼロ値のMemFSは、空のルートディレクトリのみを持つ使用可能なファイルシステムです。
+type MemFS struct {
+	mu   sync.Mutex
+	root *memNode
+}
+
+// LinkErrorは、[MemFS.Rename]、[MemFS.Symlink]、または [MemFS.Link] の間のエラーと、
+// それを引き起こしたパスを記録します。
+type LinkError struct {
+	Op  string
+	Old string
+	New string
+	Err error
+}
+
+func (e *LinkError) Error() string
+
+func (e *LinkError) Unwrap() error
+
+var _ fs.FS = (*MemFS)(nil)
+var _ fs.ReadFileFS = (*MemFS)(nil)
+var _ fs.ReadDirFS = (*MemFS)(nil)
+var _ fs.StatFS = (*MemFS)(nil)
+var _ fs.ReadLinkFS = (*MemFS)(nil)
+var _ fs.SubFS = (*MemFS)(nil)
+
+// NewMemFSは、mの内容をコピーした新しい [MemFS] を返します。
+// mがnilの場合、空のファイルシステムを返します。
+// 以後のmの変更は、返されたMemFSに影響しません。
+func NewMemFS(m MapFS) *MemFS
+
+// Openは、読み取り用に指定されたファイルを開きます。
+// これは [fs.FS] インターフェースを実装します。
+func (fsys *MemFS) Open(name string) (fs.File, error)
+
+// Createは、指定されたファイルを作成するか切り詰めます。
+// ファイルがすでに存在する場合は切り詰められます。
+// ファイルが存在しない場合は、モード0o666で作成されます。
+func (fsys *MemFS) Create(name string) (*MemFile, error)
+
+// OpenFileは、指定されたフラグ（O_RDONLYなど）とパーミッション（0o644など）で
+// 指定されたファイルを開きます。フラグの意味は [os.OpenFile] と同じです。
+// ファイルが存在せずO_CREATEフラグが指定された場合、パーミッションpermで作成されます。
+// O_CREATEとO_EXCLが両方指定され、ファイルがすでに存在する場合はエラーになります。
+// 読み取りが許可されていないファイルを読み取りのために開くか、
+// 書き込みが許可されていないファイルを書き込みのために開くと、
+// [fs.ErrPermission] をラップしたエラーが返されます。
+func (fsys *MemFS) OpenFile(name string, flag int, perm fs.FileMode) (*MemFile, error)
+
+// OpenRootは、指定されたディレクトリをルートとする [MemFS] を返します。
+// 返されたMemFSはfsysと内容を共有し、一方に対する変更は他方から見えます。
+func (fsys *MemFS) OpenRoot(name string) (*MemFS, error)
+
+// Subは、dirをルートとするfsysのサブツリーを返します。
+// これは [fs.SubFS] インターフェースを実装します。
+func (fsys *MemFS) Sub(dir string) (fs.FS, error)
+
+// Mkdirは、指定された名前とパーミッションビットで新しいディレクトリを作成します。
+// 親ディレクトリが存在しない場合や、すでに同じ名前のファイルが存在する場合はエラーになります。
+func (fsys *MemFS) Mkdir(name string, perm fs.FileMode) error
+
+// MkdirAllは、必要な親ディレクトリと共に、指定されたディレクトリを作成します。
+// 作成されるすべてのディレクトリにはパーミッションビットpermが使用されます。
+// nameがすでにディレクトリである場合、MkdirAllは何もせずにnilを返します。
+func (fsys *MemFS) MkdirAll(name string, perm fs.FileMode) error
+
+// Removeは、指定されたファイルまたは空のディレクトリを削除します。
+// 空でないディレクトリを削除しようとするとエラーになります。
+// シンボリックリンクはリンク自体が削除されます。
+func (fsys *MemFS) Remove(name string) error
+
+// RemoveAllは、指定されたパスとその子要素をすべて削除します。
+// パスが存在しない場合、RemoveAllはnilを返します。
+func (fsys *MemFS) RemoveAll(name string) error
+
+// Renameは、oldnameをnewnameに名前変更（移動）します。
+// newnameがすでに存在し、ディレクトリでない場合は置き換えられます。
+// newnameが空のディレクトリである場合、oldnameもディレクトリであれば置き換えられます。
+// ディレクトリを自身のサブディレクトリに移動しようとするとエラーになります。
+func (fsys *MemFS) Rename(oldname, newname string) error
+
+// Linkは、oldnameへのハードリンクとしてnewnameを作成します。
+// ディレクトリへのハードリンクは作成できません。
+func (fsys *MemFS) Link(oldname, newname string) error
+
+// Symlinkは、oldnameへのシンボリックリンクとしてnewnameを作成します。
+// oldnameは存在している必要はありません。
+func (fsys *MemFS) Symlink(oldname, newname string) error
+
+// ReadLinkは、指定されたシンボリックリンクのリンク先を返します。
+// これは [fs.ReadLinkFS] インターフェースを実装します。
+func (fsys *MemFS) ReadLink(name string) (string, error)
+
+// Statは、指定されたファイルを説明する [fs.FileInfo] を返します。
+// シンボリックリンクは辿られます。
+func (fsys *MemFS) Stat(name string) (fs.FileInfo, error)
+
+// Lstatは、指定されたファイルを説明する [fs.FileInfo] を返します。
+// ファイルがシンボリックリンクの場合、返されるFileInfoはそのシンボリックリンク自体を説明します。
+func (fsys *MemFS) Lstat(name string) (fs.FileInfo, error)
+
+// Chmodは、指定されたファイルのモードをmodeに変更します。
+// パーミッションビットと、[fs.ModeSetuid]、[fs.ModeSetgid]、[fs.ModeSticky] のみが使用されます。
+// ファイルがシンボリックリンクの場合、リンク先のモードが変更されます。
+func (fsys *MemFS) Chmod(name string, mode fs.FileMode) error
+
+// Chtimesは、指定されたファイルのアクセス時刻と変更時刻を変更します。
+// ゼロの [time.Time] 値は、対応する時刻を変更しないことを意味します。
+func (fsys *MemFS) Chtimes(name string, atime time.Time, mtime time.Time) error
+
+// ReadFileは、指定されたファイルを読み取り、その内容を返します。
+// これは [fs.ReadFileFS] インターフェースを実装します。
+func (fsys *MemFS) ReadFile(name string) ([]byte, error)
+
+// WriteFileは、指定されたファイルにdataを書き込み、必要に応じて作成します。
+// ファイルが存在しない場合、パーミッションpermで作成されます。
+// 存在する場合は、書き込みの前に切り詰められます。
+func (fsys *MemFS) WriteFile(name string, data []byte, perm fs.FileMode) error
+
+// ReadDirは、指定されたディレクトリを読み取り、
+// ファイル名でソートされたディレクトリエントリのリストを返します。
+// これは [fs.ReadDirFS] インターフェースを実装します。
+func (fsys *MemFS) ReadDir(name string) ([]fs.DirEntry, error)
+
+// MapFSは、fsysの現在の内容のスナップショットを [MapFS] として返します。
+// 返されたMapFSは、fsysのその後の変更の影響を受けません。
+func (fsys *MemFS) MapFS() MapFS
+
+// MemFileは、[MemFS] 内の開かれたファイルです。
+// そのメソッドは [os.File] の対応するメソッドと同じように振る舞います。
+type MemFile struct {
+	fsys   *MemFS
+	name   string
+	node   *memNode
+	flag   int
+	offset int64
+	dirPos int
+	closed bool
+}
+
+var _ fs.File = (*MemFile)(nil)
+var _ fs.ReadDirFile = (*MemFile)(nil)
+
+// Nameは、開かれたときに指定されたファイルの名前を返します。
+func (f *MemFile) Name() string
+
+// Statは、ファイルを説明する [fs.FileInfo] を返します。
+func (f *MemFile) Stat() (fs.FileInfo, error)
+
+// Readは、ファイルから最大len(b)バイトを読み取りbに格納します。
+// ファイルの終わりでは、0と [io.EOF] を返します。
+func (f *MemFile) Read(b []byte) (n int, err error)
+
+// ReadAtは、オフセットoffからlen(b)バイトを読み取ります。
+// n < len(b)の場合、常にnilでないエラーを返します。
+func (f *MemFile) ReadAt(b []byte, off int64) (n int, err error)
+
+// Writeは、bをファイルに書き込みます。
+// ファイルがO_APPENDで開かれた場合、書き込みは常にファイルの末尾に対して行われます。
+func (f *MemFile) Write(b []byte) (n int, err error)
+
+// WriteAtは、オフセットoffからbを書き込みます。
+// ファイルがO_APPENDで開かれた場合、WriteAtはエラーを返します。
+// ファイルの末尾を越えて書き込むと、間はゼロで埋められます。
+func (f *MemFile) WriteAt(b []byte, off int64) (n int, err error)
+
+// WriteStringは [MemFile.Write] と同様ですが、バイトのスライスではなく文字列sの内容を書き込みます。
+func (f *MemFile) WriteString(s string) (n int, err error)
+
+// Seekは、次のReadまたはWriteのオフセットを設定します。
+// whenceの解釈は [io.Seeker] と同じです。
+func (f *MemFile) Seek(offset int64, whence int) (ret int64, err error)
+
+// Truncateは、ファイルのサイズを変更します。
+// ファイルのオフセットは変更されません。
+func (f *MemFile) Truncate(size int64) error
+
+// Syncは何もせずにnilを返します。
+// [os.File.Sync] との互換性のために提供されています。
+func (f *MemFile) Sync() error
+
+// Chmodは、ファイルのモードをmodeに変更します。
+func (f *MemFile) Chmod(mode fs.FileMode) error
+
+// ReadDirは、ディレクトリの内容を読み取ります。
+// 引数nの意味は [fs.ReadDirFile] と同じです。
+func (f *MemFile) ReadDir(n int) ([]fs.DirEntry, error)
+
+// Closeはファイルを閉じます。
+// すでに閉じられたファイルに対するCloseはエラーを返します。
+func (f *MemFile) Close() error
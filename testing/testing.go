@@ -355,6 +355,21 @@
 //	    // <tear-down code>
 //	}
 //
+// # JSON出力
+//
+// テストバイナリに -test.json フラグを指定すると、testingパッケージは
+// 人間向けの -test.v 出力の代わりに、[cmd/test2json] と互換性のある
+// JSONイベントのストリームを標準出力に直接書き込みます。
+// テスト出力を後から解析する必要がないため、テストが "=== RUN" のような
+// フレーミング行に似たテキストを出力しても、結果が誤って解釈されることはありません。
+//
+// 各イベントは改行で区切られた1つのJSONオブジェクトで、サブテストの開始、一時停止、
+// 再開、結果のほか、[T.Attr] で記録された属性、ベンチマークの反復回数と
+// [B.ReportMetric] で報告されたものを含むメトリクス、およびfuzzingの進捗を表します。
+// イベントの形式の詳細については、'go doc test2json' を参照してください。
+//
+// "go test -json" は、テストバイナリに -test.json を渡してこの出力を直接読み取ります。
+//
 // # Main
 //
 // It is sometimes necessary for a test or benchmark program to do extra setup or teardown
@@ -415,6 +430,9 @@ func CoverMode() string
 // Verboseは、-test.vフラグが設定されているかどうかを報告します。
 func Verbose() bool
 
+// JSONは、-test.jsonフラグが設定されているかどうかを報告します。
+func JSON() bool
+
 // TBは [T], [B], [F] に共通するインターフェースです。
 type TB interface {
 	ArtifactDir() string